@@ -0,0 +1,5 @@
+data "sonarr_calendar" "example" {
+  start = "-1d"
+  end   = "7d"
+  tags  = [1]
+}
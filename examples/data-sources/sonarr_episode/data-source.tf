@@ -0,0 +1,5 @@
+data "sonarr_episode" "example" {
+  series_id      = 1
+  season_number  = 1
+  episode_number = 1
+}
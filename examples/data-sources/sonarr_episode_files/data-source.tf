@@ -0,0 +1,3 @@
+data "sonarr_episode_files" "example" {
+  series_id = 1
+}
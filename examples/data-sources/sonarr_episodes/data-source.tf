@@ -0,0 +1,5 @@
+data "sonarr_episodes" "example" {
+  series_id     = 1
+  season_number = 1
+  has_file      = false
+}
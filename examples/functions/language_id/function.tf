@@ -0,0 +1,14 @@
+resource "sonarr_custom_format" "example" {
+  include_custom_format_when_renaming = false
+  name                                = "French"
+
+  specifications = [
+    {
+      name           = "French"
+      implementation = "LanguageSpecification"
+      negate         = false
+      required       = true
+      value          = tostring(provider::sonarr::language_id("French"))
+    }
+  ]
+}
@@ -0,0 +1,18 @@
+resource "sonarr_quality_profile" "example" {
+  name            = "example-1080p"
+  upgrade_allowed = true
+  cutoff          = provider::sonarr::quality_id("HDTV-1080p")
+
+  quality_groups = [
+    {
+      qualities = [
+        {
+          id         = provider::sonarr::quality_id("HDTV-1080p")
+          name       = "HDTV-1080p"
+          source     = "television"
+          resolution = 1080
+        }
+      ]
+    }
+  ]
+}
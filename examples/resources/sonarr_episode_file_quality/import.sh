@@ -0,0 +1,2 @@
+# import using the episode file ID
+terraform import sonarr_episode_file_quality.example 10
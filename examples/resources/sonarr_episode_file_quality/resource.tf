@@ -0,0 +1,5 @@
+resource "sonarr_episode_file_quality" "example" {
+  episode_file_id = 10
+  quality         = "WEBDL-1080p"
+  languages       = ["English", "Italian"]
+}
@@ -0,0 +1,2 @@
+# import using the series ID and a comma separated list of episode IDs
+terraform import sonarr_episode_monitoring.example 1:10,13
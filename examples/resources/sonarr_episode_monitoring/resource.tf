@@ -0,0 +1,15 @@
+resource "sonarr_episode_monitoring" "example" {
+  series_id = 1
+  monitored = true
+
+  episodes = [
+    {
+      season_number  = 0
+      episode_number = 1
+    },
+    {
+      season_number  = 0
+      episode_number = 4
+    },
+  ]
+}
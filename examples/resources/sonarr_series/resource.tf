@@ -1,14 +1,39 @@
 resource "sonarr_series" "example" {
-  title      = "Breaking Bad"
-  title_slug = "breaking-bad"
-  tvdb_id    = 81189
+  # title, title_slug and path are looked up from tvdb_id
+  tvdb_id          = 81189
+  root_folder_path = "/tmp/"
 
   monitored           = true
   season_folder       = true
   use_scene_numbering = false
-  path                = "/tmp/breaking_bad"
-  root_folder_path    = "/tmp/"
 
   quality_profile_id = 1
   tags               = [1]
+  series_type        = "standard"
+  monitor_new_items  = "all"
+
+  delete_files_on_destroy              = true
+  add_import_list_exclusion_on_destroy = true
+
+  seasons = [
+    {
+      season_number = 0
+      monitored     = false
+    },
+    {
+      season_number = 1
+      monitored     = true
+    },
+  ]
+
+  add_options = {
+    monitor                     = "future"
+    search_for_missing_episodes = false
+  }
+
+  wait_for_refresh = true
+
+  timeouts {
+    create = "30m"
+  }
 }
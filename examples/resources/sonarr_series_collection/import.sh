@@ -0,0 +1,2 @@
+# import using a comma separated list of TVDB IDs
+terraform import sonarr_series_collection.example 81189,73244
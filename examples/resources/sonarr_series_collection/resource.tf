@@ -0,0 +1,18 @@
+resource "sonarr_series_collection" "example" {
+  series = {
+    "81189" = {
+      root_folder_path   = "/tmp/"
+      quality_profile_id = 1
+      tags               = [1, 2]
+    }
+    "73244" = {
+      root_folder_path   = "/tmp/"
+      quality_profile_id = 1
+      monitored          = false
+      series_type        = "standard"
+      monitor_new_items  = "none"
+    }
+  }
+
+  delete_files_on_destroy = false
+}
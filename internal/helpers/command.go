@@ -0,0 +1,119 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+)
+
+const (
+	commandPollMin = 500 * time.Millisecond
+	commandPollMax = 5 * time.Second
+)
+
+// ErrCommandFailed is returned when a Sonarr command does not complete successfully.
+var ErrCommandFailed = errors.New("command did not complete")
+
+// LastCommandID returns the ID of the latest command with the given name, 0 if none.
+// It is used to identify the commands queued by a following request.
+func LastCommandID(auth context.Context, client *sonarr.APIClient, name string) (int32, error) {
+	commands, _, err := client.CommandAPI.ListCommand(auth).Execute()
+	if err != nil {
+		return 0, err
+	}
+
+	var last int32
+
+	for _, command := range commands {
+		if command.GetName() == name && command.GetId() > last {
+			last = command.GetId()
+		}
+	}
+
+	return last, nil
+}
+
+// WaitForCommands waits until all the commands with the given name and ID greater than after are finished.
+// If series IDs are given, only the commands on any of them are waited.
+// The context must carry the client auth, see WithAuth, and bounds both the wait and the API calls.
+func WaitForCommands(ctx context.Context, client *sonarr.APIClient, name string, after int32, seriesIDs ...int32) error {
+	wait := commandPollMin
+
+	for {
+		commands, series, err := listCommands(ctx, client)
+		if err != nil {
+			return err
+		}
+
+		running := false
+
+		for i, command := range commands {
+			if command.GetName() != name || command.GetId() <= after {
+				continue
+			}
+
+			if len(seriesIDs) > 0 && !slices.ContainsFunc(series[i], func(id int32) bool { return slices.Contains(seriesIDs, id) }) {
+				continue
+			}
+
+			switch command.GetStatus() {
+			case sonarr.COMMANDSTATUS_COMPLETED:
+			case sonarr.COMMANDSTATUS_QUEUED, sonarr.COMMANDSTATUS_STARTED:
+				running = true
+			default:
+				return fmt.Errorf("%w: %s %s: %s", ErrCommandFailed, name, command.GetStatus(), command.GetMessage())
+			}
+		}
+
+		if !running {
+			return nil
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		wait = min(wait*2, commandPollMax)
+	}
+}
+
+// listCommands returns the commands along with the series IDs of their body.
+// The client model does not expose the body properties, so they are decoded from the raw response.
+func listCommands(ctx context.Context, client *sonarr.APIClient) ([]sonarr.CommandResource, [][]int32, error) {
+	commands, httpResp, err := client.CommandAPI.ListCommand(ctx).Execute()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var bodies []struct {
+		Body struct {
+			SeriesIDs []int32 `json:"seriesIds"`
+			SeriesID  int32   `json:"seriesId"`
+		} `json:"body"`
+	}
+
+	if err := json.NewDecoder(httpResp.Body).Decode(&bodies); err != nil {
+		return nil, nil, err
+	}
+
+	series := make([][]int32, len(bodies))
+
+	for i, command := range bodies {
+		series[i] = command.Body.SeriesIDs
+		if command.Body.SeriesID != 0 {
+			series[i] = append(series[i], command.Body.SeriesID)
+		}
+	}
+
+	return commands, series, nil
+}
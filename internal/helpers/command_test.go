@@ -0,0 +1,126 @@
+package helpers
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLastCommandID(t *testing.T) {
+	t.Parallel()
+
+	client := testCommandClient(t, [][]map[string]interface{}{{
+		{"id": 3, "name": "MoveSeries", "status": "completed"},
+		{"id": 7, "name": "RefreshSeries", "status": "completed"},
+		{"id": 5, "name": "MoveSeries", "status": "started"},
+	}})
+
+	last, err := LastCommandID(context.Background(), client, "MoveSeries")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(5), last)
+
+	last, err = LastCommandID(context.Background(), client, "RenameSeries")
+	assert.Nil(t, err)
+	assert.Equal(t, int32(0), last)
+}
+
+func TestWaitForCommands(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		polls     [][]map[string]interface{}
+		timeout   time.Duration
+		err       error
+		seriesIDs []int32
+	}{
+		"no_commands": {
+			polls: [][]map[string]interface{}{{}},
+		},
+		"completed": {
+			polls: [][]map[string]interface{}{{{"id": 2, "name": "MoveSeries", "status": "completed"}}},
+		},
+		"running": {
+			polls: [][]map[string]interface{}{
+				{{"id": 2, "name": "MoveSeries", "status": "queued"}},
+				{{"id": 2, "name": "MoveSeries", "status": "started"}},
+				{{"id": 2, "name": "MoveSeries", "status": "completed"}},
+			},
+		},
+		"failed": {
+			polls: [][]map[string]interface{}{{{"id": 2, "name": "MoveSeries", "status": "failed", "message": "Access denied"}}},
+			err:   ErrCommandFailed,
+		},
+		"previous_ignored": {
+			polls: [][]map[string]interface{}{{
+				{"id": 1, "name": "MoveSeries", "status": "failed"},
+				{"id": 2, "name": "RefreshSeries", "status": "started"},
+			}},
+		},
+		"other_series_ignored": {
+			polls: [][]map[string]interface{}{{
+				{"id": 2, "name": "MoveSeries", "status": "started", "body": map[string]interface{}{"seriesId": 5}},
+				{"id": 3, "name": "MoveSeries", "status": "completed", "body": map[string]interface{}{"seriesId": 4}},
+			}},
+			seriesIDs: []int32{4},
+		},
+		"series_in_list": {
+			polls: [][]map[string]interface{}{{
+				{"id": 2, "name": "MoveSeries", "status": "failed", "body": map[string]interface{}{"seriesIds": []int32{3, 4}}},
+			}},
+			seriesIDs: []int32{4},
+			err:       ErrCommandFailed,
+		},
+		"timeout": {
+			polls:   [][]map[string]interface{}{{{"id": 2, "name": "MoveSeries", "status": "started"}}},
+			timeout: 100 * time.Millisecond,
+			err:     context.DeadlineExceeded,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			if test.timeout > 0 {
+				var cancel context.CancelFunc
+
+				ctx, cancel = context.WithTimeout(ctx, test.timeout)
+				defer cancel()
+			}
+
+			err := WaitForCommands(ctx, testCommandClient(t, test.polls), "MoveSeries", 1, test.seriesIDs...)
+			assert.ErrorIs(t, err, test.err)
+		})
+	}
+}
+
+// testCommandClient returns a client listing the given commands, one snapshot per call.
+// The last snapshot is repeated once reached.
+func testCommandClient(t *testing.T, polls [][]map[string]interface{}) *sonarr.APIClient {
+	t.Helper()
+
+	var calls atomic.Int32
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		poll := min(int(calls.Add(1))-1, len(polls)-1)
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(polls[poll])
+	}))
+	t.Cleanup(server.Close)
+
+	config := sonarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+
+	return sonarr.NewAPIClient(config)
+}
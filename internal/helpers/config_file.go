@@ -0,0 +1,95 @@
+package helpers
+
+import (
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"net"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+)
+
+var (
+	ErrEmptyAPIKeyFile = errors.New("API key file is empty")
+	ErrInvalidConfig   = errors.New("invalid Sonarr config.xml")
+)
+
+// SonarrConfig is the subset of the Sonarr config.xml used to connect to Sonarr.
+type SonarrConfig struct {
+	XMLName     xml.Name `xml:"Config"`
+	BindAddress string   `xml:"BindAddress"`
+	APIKey      string   `xml:"ApiKey"`
+	URLBase     string   `xml:"UrlBase"`
+	Port        int      `xml:"Port"`
+	SslPort     int      `xml:"SslPort"`
+	EnableSsl   bool     `xml:"EnableSsl"`
+}
+
+// ReadAPIKeyFile reads the API key from a secret file, ignoring surrounding whitespaces.
+func ReadAPIKeyFile(path string) (string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("unable to read API key file: %w", err)
+	}
+
+	key := strings.TrimSpace(string(content))
+	if key == "" {
+		return "", fmt.Errorf("%w: %s", ErrEmptyAPIKeyFile, path)
+	}
+
+	return key, nil
+}
+
+// ReadSonarrConfig reads and parses the Sonarr config.xml.
+func ReadSonarrConfig(path string) (*SonarrConfig, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read Sonarr config.xml: %w", err)
+	}
+
+	config := &SonarrConfig{}
+	if err := xml.Unmarshal(content, config); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrInvalidConfig, err)
+	}
+
+	if config.APIKey == "" {
+		return nil, fmt.Errorf("%w: missing ApiKey", ErrInvalidConfig)
+	}
+
+	return config, nil
+}
+
+// CompleteURL fills the URL parts missing from the given one.
+// If the URL has no host, it is built from the config to reach Sonarr locally,
+// otherwise only the URL base is added when the URL has no path.
+func (c *SonarrConfig) CompleteURL(u *url.URL) *url.URL {
+	output := *u
+	urlBase := "/" + strings.Trim(c.URLBase, "/")
+
+	if output.Host == "" {
+		host := c.BindAddress
+		if host == "" || host == "*" {
+			host = "localhost"
+		}
+
+		output.Scheme = "http"
+		port := c.Port
+
+		if c.EnableSsl {
+			output.Scheme = "https"
+			port = c.SslPort
+		}
+
+		output.Host = net.JoinHostPort(host, strconv.Itoa(port))
+		output.Path = ""
+	}
+
+	if strings.Trim(output.Path, "/") == "" && urlBase != "/" {
+		output.Path = urlBase
+		output.RawPath = ""
+	}
+
+	return &output
+}
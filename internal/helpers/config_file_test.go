@@ -0,0 +1,182 @@
+package helpers
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReadAPIKeyFile(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		content  string
+		expected string
+		err      error
+	}{
+		"plain": {
+			content:  "b01df9fca2e64e459d64a09888ce7451",
+			expected: "b01df9fca2e64e459d64a09888ce7451",
+		},
+		"trailing_newline": {
+			content:  "b01df9fca2e64e459d64a09888ce7451\n",
+			expected: "b01df9fca2e64e459d64a09888ce7451",
+		},
+		"empty": {
+			content: " \n",
+			err:     ErrEmptyAPIKeyFile,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			file := filepath.Join(t.TempDir(), "api_key")
+			assert.NoError(t, os.WriteFile(file, []byte(test.content), 0o600))
+
+			key, err := ReadAPIKeyFile(file)
+			assert.ErrorIs(t, err, test.err)
+			assert.Equal(t, test.expected, key)
+		})
+	}
+}
+
+func TestReadAPIKeyFileMissing(t *testing.T) {
+	t.Parallel()
+
+	_, err := ReadAPIKeyFile(filepath.Join(t.TempDir(), "missing"))
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+func TestReadSonarrConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		expected *SonarrConfig
+		err      error
+		content  string
+	}{
+		"valid": {
+			content: `<Config>
+  <BindAddress>*</BindAddress>
+  <Port>8989</Port>
+  <SslPort>9898</SslPort>
+  <EnableSsl>False</EnableSsl>
+  <LaunchBrowser>True</LaunchBrowser>
+  <ApiKey>b01df9fca2e64e459d64a09888ce7451</ApiKey>
+  <AuthenticationMethod>None</AuthenticationMethod>
+  <UrlBase>/sonarr</UrlBase>
+</Config>`,
+			expected: &SonarrConfig{
+				BindAddress: "*",
+				APIKey:      "b01df9fca2e64e459d64a09888ce7451",
+				URLBase:     "/sonarr",
+				Port:        8989,
+				SslPort:     9898,
+			},
+		},
+		"ssl": {
+			content: `<Config><Port>8989</Port><SslPort>9898</SslPort><EnableSsl>True</EnableSsl><ApiKey>key</ApiKey></Config>`,
+			expected: &SonarrConfig{
+				APIKey:    "key",
+				Port:      8989,
+				SslPort:   9898,
+				EnableSsl: true,
+			},
+		},
+		"not_xml": {
+			content: `{"apiKey": "key"}`,
+			err:     ErrInvalidConfig,
+		},
+		"truncated": {
+			content: `<Config><ApiKey>key</ApiKey>`,
+			err:     ErrInvalidConfig,
+		},
+		"wrong_root": {
+			content: `<Settings><ApiKey>key</ApiKey></Settings>`,
+			err:     ErrInvalidConfig,
+		},
+		"invalid_port": {
+			content: `<Config><Port>http</Port><ApiKey>key</ApiKey></Config>`,
+			err:     ErrInvalidConfig,
+		},
+		"missing_api_key": {
+			content: `<Config><Port>8989</Port></Config>`,
+			err:     ErrInvalidConfig,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			file := filepath.Join(t.TempDir(), "config.xml")
+			assert.NoError(t, os.WriteFile(file, []byte(test.content), 0o600))
+
+			config, err := ReadSonarrConfig(file)
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+
+			config.XMLName = test.expected.XMLName
+			assert.Equal(t, test.expected, config)
+		})
+	}
+}
+
+func TestSonarrConfigCompleteURL(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		config   SonarrConfig
+		url      string
+		expected string
+	}{
+		"local": {
+			config:   SonarrConfig{BindAddress: "*", Port: 8989},
+			url:      "",
+			expected: "http://localhost:8989",
+		},
+		"local_ssl": {
+			config:   SonarrConfig{BindAddress: "127.0.0.1", Port: 8989, SslPort: 9898, EnableSsl: true, URLBase: "sonarr"},
+			url:      "",
+			expected: "https://127.0.0.1:9898/sonarr",
+		},
+		"url_base": {
+			config:   SonarrConfig{Port: 8989, URLBase: "/sonarr/"},
+			url:      "https://media.example.com",
+			expected: "https://media.example.com/sonarr",
+		},
+		"explicit_path": {
+			config:   SonarrConfig{Port: 8989, URLBase: "/sonarr"},
+			url:      "https://media.example.com/tv",
+			expected: "https://media.example.com/tv",
+		},
+		"explicit_port": {
+			config:   SonarrConfig{Port: 8989},
+			url:      "http://sonarr:1234",
+			expected: "http://sonarr:1234",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			parsed, err := url.Parse(test.url)
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, test.config.CompleteURL(parsed).String())
+		})
+	}
+}
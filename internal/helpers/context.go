@@ -0,0 +1,22 @@
+package helpers
+
+import "context"
+
+// authContext is a context carrying the values of another one, as fallback to its own.
+type authContext struct {
+	context.Context
+	auth context.Context
+}
+
+func (c authContext) Value(key any) any {
+	if value := c.Context.Value(key); value != nil {
+		return value
+	}
+
+	return c.auth.Value(key)
+}
+
+// WithAuth returns a copy of ctx carrying the client auth values, so that the API calls are bounded by ctx.
+func WithAuth(ctx, auth context.Context) context.Context {
+	return authContext{Context: ctx, auth: auth}
+}
@@ -0,0 +1,30 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWithAuth(t *testing.T) {
+	t.Parallel()
+
+	keys := map[string]sonarr.APIKey{"X-Api-Key": {Key: "key"}}
+	auth := context.WithValue(context.Background(), sonarr.ContextAPIKeys, keys)
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	merged := WithAuth(ctx, auth)
+	assert.Equal(t, keys, merged.Value(sonarr.ContextAPIKeys))
+
+	deadline, ok := merged.Deadline()
+	assert.True(t, ok)
+	assert.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+
+	cancel()
+	assert.ErrorIs(t, merged.Err(), context.Canceled)
+}
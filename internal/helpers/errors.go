@@ -1,7 +1,12 @@
 package helpers
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"unicode"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 )
@@ -14,6 +19,7 @@ const (
 	Delete                            = "delete"
 	List                              = "list"
 	ClientError                       = "Client Error"
+	ResourceNotFound                  = "Resource Not Found"
 	ResourceError                     = "Resource Error"
 	DataSourceError                   = "Data Source Error"
 	UnexpectedImportIdentifier        = "Unexpected Import Identifier"
@@ -25,6 +31,15 @@ func ParseNotFoundError(kind, field, search string) string {
 	return fmt.Sprintf("Unable to find %s, got error: data source not found: no %s with %s '%s'", kind, kind, field, search)
 }
 
+func ParseRemovedFromStateWarning(name string) string {
+	return fmt.Sprintf("Unable to find %s, it will be removed from state: it was probably deleted outside of Terraform", name)
+}
+
+// IsNotFound checks if the API response reports a missing resource.
+func IsNotFound(response *http.Response) bool {
+	return response != nil && response.StatusCode == http.StatusNotFound
+}
+
 func WrongClient(clientType string, providerData interface{}) string {
 	return fmt.Sprintf("Expected %s, got: %T. Please report this issue to the provider developers.", clientType, providerData)
 }
@@ -36,3 +51,64 @@ func ParseClientError(action, name string, err error) string {
 
 	return fmt.Sprintf("Unable to %s %s, got error: %s", action, name, err)
 }
+
+// ValidationFailure is a single validation failure returned by Sonarr on a rejected payload.
+type ValidationFailure struct {
+	PropertyName string `json:"propertyName"`
+	ErrorMessage string `json:"errorMessage"`
+	Severity     string `json:"severity"`
+	IsWarning    bool   `json:"isWarning"`
+}
+
+// IsWarningSeverity checks if the failure does not prevent the request.
+func (v ValidationFailure) IsWarningSeverity() bool {
+	return v.IsWarning || strings.EqualFold(v.Severity, "warning") || strings.EqualFold(v.Severity, "info")
+}
+
+// AttributeName maps the failure property to a Terraform attribute name.
+// Properties referring to a provider field are looked up in the fields lists,
+// the others are converted to snake case.
+func (v ValidationFailure) AttributeName(fields Fields) string {
+	if name, ok := fields.attributeName(v.PropertyName); ok {
+		return name
+	}
+
+	return toSnakeCase(v.PropertyName)
+}
+
+// ParseValidationFailures decodes the validation failures from a client error.
+// It returns nil if the error does not contain any.
+func ParseValidationFailures(err error) []ValidationFailure {
+	var apiErr *sonarr.GenericOpenAPIError
+	if !errors.As(err, &apiErr) {
+		return nil
+	}
+
+	var failures []ValidationFailure
+	if json.Unmarshal(apiErr.Body(), &failures) != nil {
+		return nil
+	}
+
+	return failures
+}
+
+func ParseValidationError(action, name string, failure ValidationFailure) string {
+	return fmt.Sprintf("Unable to %s %s, got validation error: %s", action, name, failure.ErrorMessage)
+}
+
+// toSnakeCase converts a Sonarr property name (e.g. QualityProfileId) to a Terraform attribute name.
+func toSnakeCase(name string) string {
+	var builder strings.Builder
+
+	runes := []rune(name)
+	for i, r := range runes {
+		if unicode.IsUpper(r) && i > 0 && (unicode.IsLower(runes[i-1]) || unicode.IsDigit(runes[i-1]) ||
+			(i+1 < len(runes) && unicode.IsLower(runes[i+1]) && unicode.IsUpper(runes[i-1]))) {
+			builder.WriteRune('_')
+		}
+
+		builder.WriteRune(unicode.ToLower(r))
+	}
+
+	return builder.String()
+}
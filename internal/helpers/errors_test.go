@@ -1,7 +1,10 @@
 package helpers
 
 import (
+	"context"
 	"errors"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -89,3 +92,143 @@ func TestWrongClient(t *testing.T) {
 		})
 	}
 }
+
+func TestParseRemovedFromStateWarning(t *testing.T) {
+	t.Parallel()
+
+	expected := "Unable to find tag, it will be removed from state: it was probably deleted outside of Terraform"
+	assert.Equal(t, expected, ParseRemovedFromStateWarning("tag"))
+}
+
+func TestIsNotFound(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		response *http.Response
+		expected bool
+	}{
+		"not_found": {
+			response: &http.Response{StatusCode: http.StatusNotFound},
+			expected: true,
+		},
+		"unauthorized": {
+			response: &http.Response{StatusCode: http.StatusUnauthorized},
+			expected: false,
+		},
+		"nil": {
+			response: nil,
+			expected: false,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, IsNotFound(test.response))
+		})
+	}
+}
+
+func TestParseValidationFailures(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		err      error
+		expected []ValidationFailure
+	}{
+		"validation": {
+			err: testClientError(t, `[{"propertyName":"Label","errorMessage":"'Label' must be unique","severity":"error","isWarning":false}]`),
+			expected: []ValidationFailure{
+				{PropertyName: "Label", ErrorMessage: "'Label' must be unique", Severity: "error"},
+			},
+		},
+		"message": {
+			err:      testClientError(t, `{"message":"Unauthorized"}`),
+			expected: nil,
+		},
+		"generic": {
+			err:      errors.New("other error"),
+			expected: nil,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ParseValidationFailures(test.err))
+		})
+	}
+}
+
+func TestValidationFailureAttributeName(t *testing.T) {
+	t.Parallel()
+
+	fields := Fields{
+		Strings:      []string{"host", "apiKey", "urlBase"},
+		Floats:       []string{"seedRatio"},
+		IntSlices:    []string{"fieldTags"},
+		StringSlices: []string{"recipients"},
+	}
+
+	tests := map[string]struct {
+		property string
+		expected string
+	}{
+		"field": {
+			property: "Host",
+			expected: "host",
+		},
+		"field_camel_case": {
+			property: "ApiKey",
+			expected: "api_key",
+		},
+		"field_exception": {
+			property: "SeedCriteria.SeedRatio",
+			expected: "seed_ratio",
+		},
+		"field_tags": {
+			property: "FieldTags",
+			expected: "field_tags",
+		},
+		"resource_tags": {
+			property: "Tags",
+			expected: "tags",
+		},
+		"attribute": {
+			property: "QualityProfileId",
+			expected: "quality_profile_id",
+		},
+		"acronym": {
+			property: "RSSSyncInterval",
+			expected: "rss_sync_interval",
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, ValidationFailure{PropertyName: test.property}.AttributeName(fields))
+		})
+	}
+}
+
+// testClientError returns the error of a Sonarr API call answered with a bad request and the given body.
+func testClientError(t *testing.T, body string) error {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(body))
+	}))
+	defer server.Close()
+
+	config := sonarr.NewConfiguration()
+	config.Servers[0].URL = server.URL
+	_, _, err := sonarr.NewAPIClient(config).TagAPI.CreateTag(context.Background()).TagResource(*sonarr.NewTagResource()).Execute()
+
+	return err
+}
@@ -256,6 +256,35 @@ func (f Fields) getList(list string) []string {
 	return output
 }
 
+// attributeName maps a Sonarr property (e.g. ApiKey or SeedCriteria.SeedTime) to the attribute name
+// of the matching field, if the property refers to one of the fields lists.
+// Name exceptions only apply to nested properties: a top-level Tags is the resource tags, not fieldTags.
+func (f Fields) attributeName(property string) (string, bool) {
+	segments := strings.Split(property, ".")
+	for i, s := range segments {
+		if s != "" {
+			segments[i] = strings.ToLower(s[:1]) + s[1:]
+		}
+	}
+
+	name := strings.Join(segments, ".")
+	if len(segments) > 1 {
+		name = selectTFName(name)
+	}
+	r := reflect.ValueOf(f)
+
+	for i := 0; i < r.NumField(); i++ {
+		list, _ := r.Field(i).Interface().([]string)
+		for _, field := range list {
+			if strings.EqualFold(field, name) {
+				return toSnakeCase(field), true
+			}
+		}
+	}
+
+	return "", false
+}
+
 // ReadFields takes in input a field container and populates a sonarr.Field slice.
 func ReadFields(ctx context.Context, fieldContainer interface{}, fieldLists Fields) []sonarr.Field {
 	var output []sonarr.Field
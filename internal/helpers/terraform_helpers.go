@@ -3,10 +3,13 @@ package helpers
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"strconv"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 )
 
 // ImportStatePassthroughIntID is a helper function to set the import
@@ -32,3 +35,47 @@ func ImportStatePassthroughIntID(ctx context.Context, attrPath path.Path, req re
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, attrPath, id)...)
 }
+
+// HandleReadError manages an API error during a resource read.
+// If the resource is not found it is removed from state with a warning, so that terraform plans its recreation.
+func HandleReadError(ctx context.Context, name string, httpResp *http.Response, err error, resp *resource.ReadResponse) {
+	if IsNotFound(httpResp) {
+		resp.Diagnostics.AddWarning(ResourceNotFound, ParseRemovedFromStateWarning(name))
+		resp.State.RemoveResource(ctx)
+
+		return
+	}
+
+	resp.Diagnostics.AddError(ClientError, ParseClientError(Read, name, err))
+}
+
+// HandleWriteError manages an API error during a resource create or update.
+// Sonarr validation failures are reported on the offending attribute when it is part of the plan schema.
+func HandleWriteError(ctx context.Context, action, name string, err error, fields Fields, plan tfsdk.Plan, diags *diag.Diagnostics) {
+	failed := false
+
+	for _, failure := range ParseValidationFailures(err) {
+		attributePath := path.Root(failure.AttributeName(fields))
+		_, attrDiags := plan.Schema.AttributeAtPath(ctx, attributePath)
+		mapped := failure.PropertyName != "" && !attrDiags.HasError()
+
+		switch {
+		case failure.IsWarningSeverity() && mapped:
+			diags.AddAttributeWarning(attributePath, ClientError, ParseValidationError(action, name, failure))
+		case failure.IsWarningSeverity():
+			diags.AddWarning(ClientError, ParseValidationError(action, name, failure))
+		case mapped:
+			failed = true
+
+			diags.AddAttributeError(attributePath, ClientError, ParseValidationError(action, name, failure))
+		default:
+			failed = true
+
+			diags.AddError(ClientError, ParseValidationError(action, name, failure))
+		}
+	}
+
+	if !failed {
+		diags.AddError(ClientError, ParseClientError(action, name, err))
+	}
+}
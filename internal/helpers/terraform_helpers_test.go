@@ -0,0 +1,129 @@
+package helpers
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestHandleReadError(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"id": tftypes.Number}}
+
+	tests := map[string]struct {
+		response *http.Response
+		removed  bool
+		severity diag.Severity
+	}{
+		"not_found": {
+			response: &http.Response{StatusCode: http.StatusNotFound},
+			removed:  true,
+			severity: diag.SeverityWarning,
+		},
+		"server_error": {
+			response: &http.Response{StatusCode: http.StatusInternalServerError},
+			removed:  false,
+			severity: diag.SeverityError,
+		},
+		"no_response": {
+			response: nil,
+			removed:  false,
+			severity: diag.SeverityError,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			resp := resource.ReadResponse{
+				State: tfsdk.State{
+					Schema: schema.Schema{Attributes: map[string]schema.Attribute{"id": schema.Int64Attribute{Computed: true}}},
+					Raw:    tftypes.NewValue(objectType, map[string]tftypes.Value{"id": tftypes.NewValue(tftypes.Number, 1)}),
+				},
+			}
+
+			HandleReadError(context.Background(), "tag", test.response, errors.New("error"), &resp)
+			assert.Equal(t, test.removed, resp.State.Raw.IsNull())
+			assert.Len(t, resp.Diagnostics, 1)
+			assert.Equal(t, test.severity, resp.Diagnostics[0].Severity())
+		})
+	}
+}
+
+func TestHandleWriteError(t *testing.T) {
+	t.Parallel()
+
+	plan := tfsdk.Plan{
+		Schema: schema.Schema{Attributes: map[string]schema.Attribute{
+			"label": schema.StringAttribute{Required: true},
+			"host":  schema.StringAttribute{Required: true},
+		}},
+	}
+
+	tests := map[string]struct {
+		err        error
+		paths      []path.Path
+		severities []diag.Severity
+	}{
+		"attribute": {
+			err:        testClientError(t, `[{"propertyName":"Label","errorMessage":"'Label' must be unique","severity":"error"}]`),
+			paths:      []path.Path{path.Root("label")},
+			severities: []diag.Severity{diag.SeverityError},
+		},
+		"field": {
+			err:        testClientError(t, `[{"propertyName":"Host","errorMessage":"Invalid host","severity":"error"}]`),
+			paths:      []path.Path{path.Root("host")},
+			severities: []diag.Severity{diag.SeverityError},
+		},
+		"unknown_property": {
+			err:        testClientError(t, `[{"propertyName":"Unknown","errorMessage":"Invalid","severity":"error"}]`),
+			paths:      []path.Path{path.Empty()},
+			severities: []diag.Severity{diag.SeverityError},
+		},
+		"warning_only": {
+			err:        testClientError(t, `[{"propertyName":"Host","errorMessage":"Unreachable","severity":"warning","isWarning":true}]`),
+			paths:      []path.Path{path.Root("host"), path.Empty()},
+			severities: []diag.Severity{diag.SeverityWarning, diag.SeverityError},
+		},
+		"generic": {
+			err:        errors.New("error"),
+			paths:      []path.Path{path.Empty()},
+			severities: []diag.Severity{diag.SeverityError},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			diags := diag.Diagnostics{}
+			HandleWriteError(context.Background(), Create, "tag", test.err, Fields{Strings: []string{"host"}}, plan, &diags)
+
+			assert.Len(t, diags, len(test.severities))
+
+			for i, d := range diags {
+				assert.Equal(t, test.severities[i], d.Severity())
+
+				attributePath := path.Empty()
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					attributePath = withPath.Path()
+				}
+
+				assert.Equal(t, test.paths[i], attributePath)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+package helpers
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ParseRelativeTime parses an RFC3339 timestamp or a duration relative to now, e.g. `7d` or `-1d12h`.
+// On top of the units supported by time.ParseDuration, `d` stands for 24 hours.
+func ParseRelativeTime(value string, now time.Time) (time.Time, error) {
+	if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+		return parsed, nil
+	}
+
+	invalid := fmt.Errorf("invalid time %q: must be RFC3339 or a duration like 7d", value)
+	duration, sign := strings.TrimPrefix(value, "+"), time.Duration(1)
+
+	if rest, ok := strings.CutPrefix(value, "-"); ok {
+		duration, sign = rest, -1
+	}
+
+	if duration == "" {
+		return time.Time{}, invalid
+	}
+
+	var offset time.Duration
+
+	if days, rest, ok := strings.Cut(duration, "d"); ok {
+		count, err := strconv.ParseUint(days, 10, 16)
+		if err != nil {
+			return time.Time{}, invalid
+		}
+
+		offset, duration = time.Duration(count)*24*time.Hour, rest
+	}
+
+	if duration != "" {
+		parsed, err := time.ParseDuration(duration)
+		if err != nil || parsed < 0 || strings.HasPrefix(duration, "+") {
+			return time.Time{}, invalid
+		}
+
+		offset += parsed
+	}
+
+	return now.Add(sign * offset), nil
+}
@@ -0,0 +1,74 @@
+package helpers
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRelativeTime(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2024, time.March, 10, 12, 0, 0, 0, time.UTC)
+
+	tests := map[string]struct {
+		value    string
+		expected time.Time
+		err      bool
+	}{
+		"rfc3339": {
+			value:    "2024-01-01T20:00:00Z",
+			expected: time.Date(2024, time.January, 1, 20, 0, 0, 0, time.UTC),
+		},
+		"days": {
+			value:    "7d",
+			expected: time.Date(2024, time.March, 17, 12, 0, 0, 0, time.UTC),
+		},
+		"negative_days_hours": {
+			value:    "-1d12h",
+			expected: time.Date(2024, time.March, 9, 0, 0, 0, 0, time.UTC),
+		},
+		"hours": {
+			value:    "+36h",
+			expected: time.Date(2024, time.March, 12, 0, 0, 0, 0, time.UTC),
+		},
+		"zero": {
+			value:    "0d",
+			expected: now,
+		},
+		"date_only": {
+			value: "2024-01-01",
+			err:   true,
+		},
+		"invalid_days": {
+			value: "xd",
+			err:   true,
+		},
+		"double_sign": {
+			value: "--1d",
+			err:   true,
+		},
+		"empty": {
+			value: "",
+			err:   true,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			result, err := ParseRelativeTime(test.value, now)
+			if test.err {
+				assert.Error(t, err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.True(t, test.expected.Equal(result), "expected %s, got %s", test.expected, result)
+		})
+	}
+}
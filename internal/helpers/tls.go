@@ -0,0 +1,71 @@
+package helpers
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+)
+
+var (
+	ErrInvalidCACertificate  = errors.New("no valid PEM certificate found")
+	ErrClientCertificatePair = errors.New("client certificate and client key must be set together")
+)
+
+// TLSOptions describes the TLS settings used to connect to Sonarr.
+type TLSOptions struct {
+	CACertificate      string
+	CACertificateFile  string
+	ClientCertificate  string
+	ClientKey          string
+	InsecureSkipVerify bool
+}
+
+// Config builds the tls.Config to be used by the HTTP transport.
+// Custom CA certificates are trusted along with the system ones.
+func (o TLSOptions) Config() (*tls.Config, error) {
+	config := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: o.InsecureSkipVerify,
+	}
+
+	if o.CACertificate != "" || o.CACertificateFile != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+
+		if o.CACertificateFile != "" {
+			pem, err := os.ReadFile(o.CACertificateFile)
+			if err != nil {
+				return nil, fmt.Errorf("unable to read CA certificate file: %w", err)
+			}
+
+			if !pool.AppendCertsFromPEM(pem) {
+				return nil, fmt.Errorf("%w in %s", ErrInvalidCACertificate, o.CACertificateFile)
+			}
+		}
+
+		if o.CACertificate != "" && !pool.AppendCertsFromPEM([]byte(o.CACertificate)) {
+			return nil, fmt.Errorf("%w in CA certificate", ErrInvalidCACertificate)
+		}
+
+		config.RootCAs = pool
+	}
+
+	if (o.ClientCertificate == "") != (o.ClientKey == "") {
+		return nil, ErrClientCertificatePair
+	}
+
+	if o.ClientCertificate != "" {
+		certificate, err := tls.X509KeyPair([]byte(o.ClientCertificate), []byte(o.ClientKey))
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate: %w", err)
+		}
+
+		config.Certificates = []tls.Certificate{certificate}
+	}
+
+	return config, nil
+}
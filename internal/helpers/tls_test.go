@@ -0,0 +1,115 @@
+package helpers
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTLSOptionsConfig(t *testing.T) {
+	t.Parallel()
+
+	certificate, key := testCertificate(t)
+	file := filepath.Join(t.TempDir(), "ca.pem")
+	assert.NoError(t, os.WriteFile(file, []byte(certificate), 0o600))
+
+	tests := map[string]struct {
+		options      TLSOptions
+		err          error
+		rootCAs      bool
+		certificates int
+		insecure     bool
+	}{
+		"default": {
+			options: TLSOptions{},
+		},
+		"insecure": {
+			options:  TLSOptions{InsecureSkipVerify: true},
+			insecure: true,
+		},
+		"ca_certificate": {
+			options: TLSOptions{CACertificate: certificate},
+			rootCAs: true,
+		},
+		"ca_certificate_file": {
+			options: TLSOptions{CACertificateFile: file},
+			rootCAs: true,
+		},
+		"invalid_ca_certificate": {
+			options: TLSOptions{CACertificate: "invalid"},
+			err:     ErrInvalidCACertificate,
+		},
+		"client_certificate": {
+			options:      TLSOptions{ClientCertificate: certificate, ClientKey: key},
+			certificates: 1,
+		},
+		"missing_client_key": {
+			options: TLSOptions{ClientCertificate: certificate},
+			err:     ErrClientCertificatePair,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config, err := test.options.Config()
+			if test.err != nil {
+				assert.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, test.rootCAs, config.RootCAs != nil)
+			assert.Len(t, config.Certificates, test.certificates)
+			assert.Equal(t, test.insecure, config.InsecureSkipVerify)
+		})
+	}
+}
+
+func TestTLSOptionsConfigMissingFile(t *testing.T) {
+	t.Parallel()
+
+	_, err := TLSOptions{CACertificateFile: filepath.Join(t.TempDir(), "missing.pem")}.Config()
+	assert.ErrorIs(t, err, os.ErrNotExist)
+}
+
+// testCertificate generates a self-signed certificate and its key in PEM format.
+func testCertificate(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	assert.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "sonarr"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth, x509.ExtKeyUsageServerAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	assert.NoError(t, err)
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	assert.NoError(t, err)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}))
+}
@@ -0,0 +1,401 @@
+package helpers
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// LogSubsystem is the tflog subsystem used to trace Sonarr API calls.
+const LogSubsystem = "sonarr_api"
+
+// sensitiveNames identifies the headers, JSON keys and fields which must be redacted from logs.
+var sensitiveNames = []string{"password", "apikey", "api_key", "token", "secret", "passkey"}
+
+// RetryTransport is an http.RoundTripper retrying requests failed because of transient Sonarr errors.
+// Requests are retried only when it is safe to replay them:
+// non idempotent requests are retried only if they were never processed by Sonarr.
+type RetryTransport struct {
+	Transport  http.RoundTripper
+	MaxRetries int
+	WaitMin    time.Duration
+	WaitMax    time.Duration
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *RetryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	for attempt := 0; ; attempt++ {
+		attemptReq, err := rewindRequest(req, attempt)
+		if err != nil {
+			return nil, err
+		}
+
+		resp, err := transport.RoundTrip(attemptReq)
+		if attempt >= t.MaxRetries || !shouldRetry(req, resp, err) {
+			return resp, err
+		}
+
+		wait := t.backoff(attempt, resp)
+
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-req.Context().Done():
+			timer.Stop()
+
+			return nil, req.Context().Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// rewindRequest returns a request with a fresh body to be sent on the given attempt.
+func rewindRequest(req *http.Request, attempt int) (*http.Request, error) {
+	if attempt == 0 || req.Body == nil || req.GetBody == nil {
+		return req, nil
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return nil, err
+	}
+
+	newReq := req.Clone(req.Context())
+	newReq.Body = body
+
+	return newReq, nil
+}
+
+// backoff calculates the exponential wait before the next attempt, honoring the Retry-After header.
+func (t *RetryTransport) backoff(attempt int, resp *http.Response) time.Duration {
+	if resp != nil {
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil && seconds >= 0 {
+			return min(time.Duration(seconds)*time.Second, t.WaitMax)
+		}
+	}
+
+	wait := t.WaitMin << attempt
+	if wait < t.WaitMin || wait > t.WaitMax {
+		wait = t.WaitMax
+	}
+
+	return wait
+}
+
+// shouldRetry identifies transient failures which can be safely retried.
+func shouldRetry(req *http.Request, resp *http.Response, err error) bool {
+	if err != nil {
+		// the request never reached Sonarr
+		var opErr *net.OpError
+		if errors.As(err, &opErr) && opErr.Op == "dial" {
+			return true
+		}
+
+		return isIdempotent(req.Method) && req.Context().Err() == nil
+	}
+
+	switch {
+	case resp.StatusCode == http.StatusTooManyRequests:
+		return true
+	case resp.StatusCode >= http.StatusInternalServerError && resp.StatusCode != http.StatusNotImplemented:
+		return isIdempotent(req.Method)
+	}
+
+	return false
+}
+
+// isIdempotent checks if the HTTP method can be replayed without side effects.
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	return false
+}
+
+// WriteLimitTransport is an http.RoundTripper limiting the concurrent write requests sent to Sonarr,
+// since its SQLite database fails with "database is locked" on parallel writes.
+// Read requests (GET, HEAD and OPTIONS) are never limited.
+type WriteLimitTransport struct {
+	Transport http.RoundTripper
+	slots     chan struct{}
+}
+
+// NewWriteLimitTransport creates a WriteLimitTransport allowing up to maxWrites concurrent writes.
+// Writes are not limited if maxWrites is 0.
+func NewWriteLimitTransport(transport http.RoundTripper, maxWrites int) *WriteLimitTransport {
+	t := &WriteLimitTransport{Transport: transport}
+	if maxWrites > 0 {
+		t.slots = make(chan struct{}, maxWrites)
+	}
+
+	return t
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *WriteLimitTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	if t.slots == nil || isRead(req.Method) {
+		return transport.RoundTrip(req)
+	}
+
+	select {
+	case t.slots <- struct{}{}:
+	case <-req.Context().Done():
+		return nil, req.Context().Err()
+	}
+
+	defer func() { <-t.slots }()
+
+	return transport.RoundTrip(req)
+}
+
+// isRead checks if the HTTP method does not change the Sonarr database.
+func isRead(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+
+	return false
+}
+
+// LoggingTransport is an http.RoundTripper logging Sonarr requests and responses through tflog.
+// Method, URL, status and latency are logged at DEBUG level, headers and bodies at TRACE level.
+// Logs are written with the request context, so that they carry the fields of the calling operation.
+type LoggingTransport struct {
+	Transport http.RoundTripper
+	secrets   []string
+	// traceBodies enables the buffering of bodies, only needed when TRACE logs are emitted.
+	traceBodies bool
+}
+
+// NewLoggingTransport creates a LoggingTransport. Secrets values are redacted wherever they appear.
+func NewLoggingTransport(transport http.RoundTripper, secrets ...string) *LoggingTransport {
+	nonEmpty := make([]string, 0, len(secrets))
+
+	for _, secret := range secrets {
+		if secret != "" {
+			nonEmpty = append(nonEmpty, secret)
+		}
+	}
+
+	return &LoggingTransport{
+		Transport:   transport,
+		secrets:     nonEmpty,
+		traceBodies: traceEnabled(),
+	}
+}
+
+// traceEnabled checks if the environment may enable the TRACE provider logs.
+func traceEnabled() bool {
+	if os.Getenv("TF_ACC_LOG_PATH") != "" {
+		return true
+	}
+
+	for _, name := range []string{"TF_LOG", "TF_LOG_PROVIDER", "TF_LOG_PROVIDER_SONARR"} {
+		switch strings.ToUpper(os.Getenv(name)) {
+		case "TRACE", "JSON":
+			return true
+		}
+	}
+
+	return false
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *LoggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	transport := t.Transport
+	if transport == nil {
+		transport = http.DefaultTransport
+	}
+
+	ctx := tflog.NewSubsystem(req.Context(), LogSubsystem, tflog.WithRootFields())
+	fields := map[string]interface{}{
+		"http_method": req.Method,
+		"http_url":    t.redactString(redactURL(req.URL.String())),
+	}
+
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Sending HTTP request", fields)
+
+	requestDetails := map[string]interface{}{
+		"http_method":      req.Method,
+		"http_url":         fields["http_url"],
+		"http_req_headers": t.redactHeaders(req.Header),
+	}
+
+	if t.traceBodies && req.Body != nil && req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			content, _ := io.ReadAll(body)
+			body.Close()
+
+			requestDetails["http_req_body"] = t.redactBody(content)
+		}
+	}
+
+	tflog.SubsystemTrace(ctx, LogSubsystem, "HTTP request details", requestDetails)
+
+	start := time.Now()
+	resp, err := transport.RoundTrip(req)
+	fields["http_duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = t.redactString(err.Error())
+		tflog.SubsystemDebug(ctx, LogSubsystem, "HTTP request failed", fields)
+
+		return resp, err
+	}
+
+	fields["http_status_code"] = resp.StatusCode
+	tflog.SubsystemDebug(ctx, LogSubsystem, "Received HTTP response", fields)
+
+	responseDetails := map[string]interface{}{
+		"http_status_code": resp.StatusCode,
+		"http_res_headers": t.redactHeaders(resp.Header),
+	}
+
+	if t.traceBodies {
+		content, readErr := io.ReadAll(resp.Body)
+		resp.Body.Close()
+		resp.Body = io.NopCloser(bytes.NewReader(content))
+
+		if readErr != nil {
+			return resp, readErr
+		}
+
+		responseDetails["http_res_body"] = t.redactBody(content)
+	}
+
+	tflog.SubsystemTrace(ctx, LogSubsystem, "HTTP response details", responseDetails)
+
+	return resp, nil
+}
+
+// redactHeaders masks the sensitive headers and the ones carrying secrets.
+func (t *LoggingTransport) redactHeaders(headers http.Header) map[string]string {
+	output := make(map[string]string, len(headers))
+
+	for name, values := range headers {
+		value := strings.Join(values, ", ")
+		if isSensitiveName(name) || strings.EqualFold(name, "Authorization") || slices.ContainsFunc(values, t.isSecret) {
+			value = SensitiveValue
+		}
+
+		output[name] = value
+	}
+
+	return output
+}
+
+// isSecret checks if the value is a known secret.
+func (t *LoggingTransport) isSecret(value string) bool {
+	return slices.Contains(t.secrets, value)
+}
+
+// redactBody masks the sensitive values of a JSON body and any known secret.
+func (t *LoggingTransport) redactBody(body []byte) string {
+	var content interface{}
+	if json.Unmarshal(body, &content) != nil {
+		return t.redactString(string(body))
+	}
+
+	redacted, err := json.Marshal(redactJSON(content))
+	if err != nil {
+		return t.redactString(string(body))
+	}
+
+	return t.redactString(string(redacted))
+}
+
+// redactString masks any known secret.
+func (t *LoggingTransport) redactString(value string) string {
+	for _, secret := range t.secrets {
+		value = strings.ReplaceAll(value, secret, SensitiveValue)
+	}
+
+	return value
+}
+
+// redactJSON masks the sensitive keys of a JSON value, as well as the values of Sonarr sensitive fields.
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, item := range v {
+			if isSensitiveName(key) {
+				if item != nil && item != "" {
+					v[key] = SensitiveValue
+				}
+
+				continue
+			}
+
+			v[key] = redactJSON(item)
+		}
+
+		// provider fields, e.g. {"name": "apiKey", "value": "secret"}
+		if name, ok := v["name"].(string); ok && isSensitiveName(name) {
+			if item, ok := v["value"]; ok && item != nil && item != "" {
+				v["value"] = SensitiveValue
+			}
+		}
+	case []interface{}:
+		for i, item := range v {
+			v[i] = redactJSON(item)
+		}
+	}
+
+	return value
+}
+
+// redactURL masks the API key passed as query parameter.
+func redactURL(rawURL string) string {
+	index := strings.Index(strings.ToLower(rawURL), "apikey=")
+	if index < 0 {
+		return rawURL
+	}
+
+	start := index + len("apikey=")
+	end := strings.IndexByte(rawURL[start:], '&')
+
+	if end < 0 {
+		return rawURL[:start] + SensitiveValue
+	}
+
+	return rawURL[:start] + SensitiveValue + rawURL[start+end:]
+}
+
+// isSensitiveName checks if a header, key or field name refers to a secret.
+func isSensitiveName(name string) bool {
+	name = strings.ToLower(strings.ReplaceAll(name, "-", ""))
+	for _, sensitive := range sensitiveNames {
+		if strings.Contains(name, sensitive) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,400 @@
+package helpers
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRetryTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method     string
+		status     int
+		failures   int32
+		maxRetries int
+		expected   int
+		attempts   int32
+	}{
+		"get_recovered": {
+			method:     http.MethodGet,
+			status:     http.StatusServiceUnavailable,
+			failures:   2,
+			maxRetries: 3,
+			expected:   http.StatusOK,
+			attempts:   3,
+		},
+		"get_exhausted": {
+			method:     http.MethodGet,
+			status:     http.StatusBadGateway,
+			failures:   5,
+			maxRetries: 2,
+			expected:   http.StatusBadGateway,
+			attempts:   3,
+		},
+		"put_recovered": {
+			method:     http.MethodPut,
+			status:     http.StatusInternalServerError,
+			failures:   1,
+			maxRetries: 3,
+			expected:   http.StatusOK,
+			attempts:   2,
+		},
+		"post_not_retried": {
+			method:     http.MethodPost,
+			status:     http.StatusInternalServerError,
+			failures:   1,
+			maxRetries: 3,
+			expected:   http.StatusInternalServerError,
+			attempts:   1,
+		},
+		"post_throttled": {
+			method:     http.MethodPost,
+			status:     http.StatusTooManyRequests,
+			failures:   1,
+			maxRetries: 3,
+			expected:   http.StatusOK,
+			attempts:   2,
+		},
+		"client_error": {
+			method:     http.MethodGet,
+			status:     http.StatusBadRequest,
+			failures:   1,
+			maxRetries: 3,
+			expected:   http.StatusBadRequest,
+			attempts:   1,
+		},
+		"disabled": {
+			method:     http.MethodGet,
+			status:     http.StatusServiceUnavailable,
+			failures:   1,
+			maxRetries: 0,
+			expected:   http.StatusServiceUnavailable,
+			attempts:   1,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var attempts atomic.Int32
+
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				if r.Method != http.MethodGet {
+					assert.Equal(t, "payload", string(body))
+				}
+
+				if attempts.Add(1) <= test.failures {
+					w.WriteHeader(test.status)
+
+					return
+				}
+
+				w.WriteHeader(http.StatusOK)
+			}))
+			defer server.Close()
+
+			client := &http.Client{Transport: &RetryTransport{MaxRetries: test.maxRetries}}
+
+			req, err := http.NewRequest(test.method, server.URL, strings.NewReader("payload"))
+			assert.NoError(t, err)
+
+			resp, err := client.Do(req)
+			assert.NoError(t, err)
+			resp.Body.Close()
+
+			assert.Equal(t, test.expected, resp.StatusCode)
+			assert.Equal(t, test.attempts, attempts.Load())
+		})
+	}
+}
+
+func TestRetryTransportConnectionRefused(t *testing.T) {
+	t.Parallel()
+
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	assert.NoError(t, err)
+
+	address := listener.Addr().String()
+	listener.Close()
+
+	var attempts atomic.Int32
+
+	transport := &RetryTransport{
+		Transport: roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+			attempts.Add(1)
+
+			return http.DefaultTransport.RoundTrip(req)
+		}),
+		MaxRetries: 2,
+	}
+
+	req, err := http.NewRequest(http.MethodPost, "http://"+address, strings.NewReader("payload"))
+	assert.NoError(t, err)
+
+	_, err = transport.RoundTrip(req)
+	assert.Error(t, err)
+	assert.Equal(t, int32(3), attempts.Load())
+}
+
+func TestRetryTransportBackoff(t *testing.T) {
+	t.Parallel()
+
+	transport := &RetryTransport{
+		WaitMin: time.Second,
+		WaitMax: 5 * time.Second,
+	}
+
+	tests := map[string]struct {
+		resp     *http.Response
+		attempt  int
+		expected time.Duration
+	}{
+		"first": {
+			attempt:  0,
+			expected: time.Second,
+		},
+		"exponential": {
+			attempt:  2,
+			expected: 4 * time.Second,
+		},
+		"capped": {
+			attempt:  3,
+			expected: 5 * time.Second,
+		},
+		"overflow": {
+			attempt:  70,
+			expected: 5 * time.Second,
+		},
+		"retry_after": {
+			resp:     &http.Response{Header: http.Header{"Retry-After": []string{"2"}}},
+			attempt:  0,
+			expected: 2 * time.Second,
+		},
+		"retry_after_capped": {
+			resp:     &http.Response{Header: http.Header{"Retry-After": []string{"60"}}},
+			attempt:  0,
+			expected: 5 * time.Second,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, transport.backoff(test.attempt, test.resp))
+		})
+	}
+}
+
+func TestWriteLimitTransport(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]struct {
+		method    string
+		maxWrites int
+		expected  int32
+	}{
+		"serialized": {
+			method:    http.MethodPost,
+			maxWrites: 1,
+			expected:  1,
+		},
+		"limited": {
+			method:    http.MethodPut,
+			maxWrites: 3,
+			expected:  3,
+		},
+		"unlimited": {
+			method:    http.MethodDelete,
+			maxWrites: 0,
+			expected:  10,
+		},
+		"reads": {
+			method:    http.MethodGet,
+			maxWrites: 1,
+			expected:  10,
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			var inFlight, peak atomic.Int32
+
+			transport := NewWriteLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+				current := inFlight.Add(1)
+				defer inFlight.Add(-1)
+
+				for {
+					old := peak.Load()
+					if current <= old || peak.CompareAndSwap(old, current) {
+						break
+					}
+				}
+
+				// hold the request until the expected concurrency is reached
+				deadline := time.Now().Add(time.Second)
+				for peak.Load() < test.expected && time.Now().Before(deadline) {
+					time.Sleep(time.Millisecond)
+				}
+
+				time.Sleep(10 * time.Millisecond)
+
+				return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+			}), test.maxWrites)
+
+			var wg sync.WaitGroup
+
+			for i := 0; i < 10; i++ {
+				wg.Add(1)
+
+				go func() {
+					defer wg.Done()
+
+					req, _ := http.NewRequest(test.method, "http://sonarr", nil)
+					_, err := transport.RoundTrip(req)
+					assert.NoError(t, err)
+				}()
+			}
+
+			wg.Wait()
+			assert.Equal(t, test.expected, peak.Load())
+		})
+	}
+}
+
+func TestWriteLimitTransportCanceled(t *testing.T) {
+	t.Parallel()
+
+	transport := NewWriteLimitTransport(roundTripperFunc(func(req *http.Request) (*http.Response, error) {
+		return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+	}), 1)
+	transport.slots <- struct{}{}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, "http://sonarr", nil)
+	_, err := transport.RoundTrip(req)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestLoggingTransport(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write(body)
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	ctx := tflog.SetField(tflogtest.RootLogger(context.Background(), &output), "tf_rpc", "ApplyResourceChange")
+	transport := NewLoggingTransport(nil, "api-key-secret", "header-secret", "")
+	transport.traceBodies = true
+	client := &http.Client{Transport: transport}
+
+	body := `{"name":"test","apiKey":"field-secret","fields":[{"name":"password","value":"password-secret"},{"name":"host","value":"localhost"}]}`
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/v3/notification?apikey=query-secret", strings.NewReader(body))
+	assert.NoError(t, err)
+	req.Header.Set("X-Api-Key", "api-key-secret")
+	req.Header.Set("X-Custom", "header-secret")
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+
+	// response body must still be readable
+	responseBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, body, string(responseBody))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+
+	assert.Equal(t, "Sending HTTP request", entries[0]["@message"])
+	assert.Equal(t, http.MethodPost, entries[0]["http_method"])
+	assert.Equal(t, "provider."+LogSubsystem, entries[0]["@module"])
+	assert.Equal(t, "ApplyResourceChange", entries[0]["tf_rpc"])
+	assert.Equal(t, "Received HTTP response", entries[2]["@message"])
+	assert.Equal(t, float64(http.StatusCreated), entries[2]["http_status_code"])
+	assert.Contains(t, entries[2], "http_duration_ms")
+
+	logs := ""
+	for _, entry := range entries {
+		logs += fmt.Sprint(entry)
+	}
+
+	for _, secret := range []string{"api-key-secret", "header-secret", "field-secret", "password-secret", "query-secret"} {
+		assert.NotContains(t, logs, secret)
+	}
+
+	assert.Contains(t, fmt.Sprint(entries[3]["http_res_body"]), "localhost")
+	assert.Contains(t, fmt.Sprint(entries[3]["http_res_body"]), SensitiveValue)
+}
+
+func TestLoggingTransportWithoutTrace(t *testing.T) {
+	t.Parallel()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"name":"test"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+	client := &http.Client{Transport: NewLoggingTransport(nil)}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(`{"name":"test"}`))
+	assert.NoError(t, err)
+
+	req.GetBody = func() (io.ReadCloser, error) {
+		t.Error("request body must not be buffered")
+
+		return nil, errors.New("unexpected body read")
+	}
+
+	resp, err := client.Do(req)
+	assert.NoError(t, err)
+
+	responseBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+	assert.Equal(t, `{"name":"test"}`, string(responseBody))
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	assert.NoError(t, err)
+	assert.Len(t, entries, 4)
+	assert.NotContains(t, entries[1], "http_req_body")
+	assert.NotContains(t, entries[3], "http_res_body")
+}
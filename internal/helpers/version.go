@@ -0,0 +1,70 @@
+package helpers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// UnsupportedVersion is the diagnostic summary for features not available on the configured Sonarr.
+const UnsupportedVersion = "Unsupported Sonarr Version"
+
+// VersionRequirement declares the minimum Sonarr version supporting a resource or,
+// if the attribute path is set, one of its attributes.
+type VersionRequirement struct {
+	Minimum   *version.Version
+	Attribute path.Path
+}
+
+// NewVersionRequirement creates a requirement for the whole resource or for the given attribute.
+func NewVersionRequirement(minimum string, attribute ...string) VersionRequirement {
+	requirement := VersionRequirement{Minimum: version.Must(version.NewVersion(minimum))}
+	if len(attribute) > 0 {
+		requirement.Attribute = path.Root(attribute[0])
+	}
+
+	return requirement
+}
+
+// ParseUnsupportedVersionError returns the error detail for a feature not supported by the current version.
+func ParseUnsupportedVersionError(feature string, minimum, current *version.Version) string {
+	return fmt.Sprintf("%s requires Sonarr >= %s, got: %s", feature, minimum, current)
+}
+
+// CheckVersionRequirements validates the configuration against the Sonarr version.
+// Attribute requirements apply only when the attribute is set and not false.
+// If the version is unknown no check is done and the API will return the errors, if any.
+func CheckVersionRequirements(ctx context.Context, name string, current *version.Version, config tfsdk.Config, requirements []VersionRequirement, diags *diag.Diagnostics) {
+	if current == nil || config.Raw.IsNull() {
+		return
+	}
+
+	for _, requirement := range requirements {
+		if !current.LessThan(requirement.Minimum) {
+			continue
+		}
+
+		if len(requirement.Attribute.Steps()) == 0 {
+			diags.AddError(UnsupportedVersion, ParseUnsupportedVersionError(name, requirement.Minimum, current))
+
+			continue
+		}
+
+		var value attr.Value
+
+		diags.Append(config.GetAttribute(ctx, requirement.Attribute, &value)...)
+
+		if value == nil || value.IsNull() || value.IsUnknown() || value.Equal(types.BoolValue(false)) {
+			continue
+		}
+
+		diags.AddAttributeError(requirement.Attribute, UnsupportedVersion,
+			ParseUnsupportedVersionError(fmt.Sprintf("%s attribute %s", name, requirement.Attribute), requirement.Minimum, current))
+	}
+}
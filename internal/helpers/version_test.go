@@ -0,0 +1,99 @@
+package helpers
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckVersionRequirements(t *testing.T) {
+	t.Parallel()
+
+	objectType := tftypes.Object{AttributeTypes: map[string]tftypes.Type{"name": tftypes.String, "on_manual": tftypes.Bool}}
+	configSchema := schema.Schema{Attributes: map[string]schema.Attribute{
+		"name":      schema.StringAttribute{Required: true},
+		"on_manual": schema.BoolAttribute{Optional: true},
+	}}
+
+	tests := map[string]struct {
+		current      *version.Version
+		onManual     tftypes.Value
+		requirements []VersionRequirement
+		paths        []path.Path
+	}{
+		"supported": {
+			current:      version.Must(version.NewVersion("4.0.1.929")),
+			onManual:     tftypes.NewValue(tftypes.Bool, true),
+			requirements: []VersionRequirement{NewVersionRequirement("4.0.0"), NewVersionRequirement("4.0.0", "on_manual")},
+		},
+		"unknown_version": {
+			onManual:     tftypes.NewValue(tftypes.Bool, true),
+			requirements: []VersionRequirement{NewVersionRequirement("4.0.0")},
+		},
+		"resource": {
+			current:      version.Must(version.NewVersion("3.0.10.1567")),
+			onManual:     tftypes.NewValue(tftypes.Bool, nil),
+			requirements: []VersionRequirement{NewVersionRequirement("4.0.0")},
+			paths:        []path.Path{path.Empty()},
+		},
+		"attribute": {
+			current:      version.Must(version.NewVersion("3.0.10.1567")),
+			onManual:     tftypes.NewValue(tftypes.Bool, true),
+			requirements: []VersionRequirement{NewVersionRequirement("4.0.0", "on_manual")},
+			paths:        []path.Path{path.Root("on_manual")},
+		},
+		"attribute_false": {
+			current:      version.Must(version.NewVersion("3.0.10.1567")),
+			onManual:     tftypes.NewValue(tftypes.Bool, false),
+			requirements: []VersionRequirement{NewVersionRequirement("4.0.0", "on_manual")},
+		},
+		"attribute_null": {
+			current:      version.Must(version.NewVersion("3.0.10.1567")),
+			onManual:     tftypes.NewValue(tftypes.Bool, nil),
+			requirements: []VersionRequirement{NewVersionRequirement("4.0.0", "on_manual")},
+		},
+		"attribute_unknown": {
+			current:      version.Must(version.NewVersion("3.0.10.1567")),
+			onManual:     tftypes.NewValue(tftypes.Bool, tftypes.UnknownValue),
+			requirements: []VersionRequirement{NewVersionRequirement("4.0.0", "on_manual")},
+		},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			config := tfsdk.Config{
+				Schema: configSchema,
+				Raw: tftypes.NewValue(objectType, map[string]tftypes.Value{
+					"name":      tftypes.NewValue(tftypes.String, "test"),
+					"on_manual": test.onManual,
+				}),
+			}
+
+			diags := diag.Diagnostics{}
+			CheckVersionRequirements(context.Background(), "sonarr_test", test.current, config, test.requirements, &diags)
+
+			assert.Len(t, diags, len(test.paths))
+
+			for i, d := range diags {
+				assert.Equal(t, UnsupportedVersion, d.Summary())
+
+				attributePath := path.Empty()
+				if withPath, ok := d.(diag.DiagnosticWithPath); ok {
+					attributePath = withPath.Path()
+				}
+
+				assert.Equal(t, test.paths[i], attributePath)
+			}
+		})
+	}
+}
@@ -2,6 +2,8 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"maps"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -95,11 +97,31 @@ func (d *AllSeriessDataSource) Schema(_ context.Context, _ datasource.SchemaRequ
 							Computed:            true,
 							ElementType:         types.Int64Type,
 						},
+						"series_type": schema.StringAttribute{
+							MarkdownDescription: "Series type.",
+							Computed:            true,
+						},
+						"monitor_new_items": schema.StringAttribute{
+							MarkdownDescription: "Monitor new items.",
+							Computed:            true,
+						},
 					},
 				},
 			},
 		},
 	}
+
+	series, ok := resp.Schema.Attributes["series"].(schema.SetNestedAttribute)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected series schema",
+			fmt.Sprintf("Expected the series attribute to be a set nested attribute, got %T. Please report this issue to the provider developers.", resp.Schema.Attributes["series"]),
+		)
+
+		return
+	}
+
+	maps.Copy(series.NestedObject.Attributes, SeriesDataSource{}.getMetadataSchema().Attributes)
 }
 
 func (d *AllSeriessDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -111,7 +133,7 @@ func (d *AllSeriessDataSource) Configure(ctx context.Context, req datasource.Con
 
 func (d *AllSeriessDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get series current value
-	response, _, err := d.client.SeriesAPI.ListSeries(d.auth).Execute()
+	response, _, err := d.client.SeriesAPI.ListSeries(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, allSeriesDataSourceName, err))
 
@@ -1,10 +1,14 @@
 package provider
 
 import (
+	"context"
 	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccAllSeriesDataSource(t *testing.T) {
@@ -27,7 +31,7 @@ func TestAccAllSeriesDataSource(t *testing.T) {
 			{
 				Config: testAccAllSeriesDataSourceConfig,
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_all_series.test", "series.*", map[string]string{"monitored": "false"}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_all_series.test", "series.*", map[string]string{"monitored": "false", "series_type": "standard"}),
 				),
 			},
 		},
@@ -38,3 +42,18 @@ const testAccAllSeriesDataSourceConfig = `
 data "sonarr_all_series" "test" {
 }
 `
+
+func TestAllSeriesDataSourceSchema(t *testing.T) {
+	t.Parallel()
+
+	resp := &datasource.SchemaResponse{}
+	(&AllSeriessDataSource{}).Schema(context.Background(), datasource.SchemaRequest{}, resp)
+	assert.False(t, resp.Diagnostics.HasError(), resp.Diagnostics)
+
+	series, ok := resp.Schema.Attributes["series"].(schema.SetNestedAttribute)
+	assert.True(t, ok)
+
+	for name := range (SeriesDataSource{}).getMetadataSchema().Attributes {
+		assert.Contains(t, series.NestedObject.Attributes, name)
+	}
+}
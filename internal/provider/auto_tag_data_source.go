@@ -5,6 +5,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -23,8 +24,9 @@ func NewAutoTagDataSource() datasource.DataSource {
 
 // AutoTagDataSource defines the auto_tag implementation.
 type AutoTagDataSource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	version *version.Version
 }
 
 func (d *AutoTagDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -87,13 +89,20 @@ func (d *AutoTagDataSource) Schema(_ context.Context, _ datasource.SchemaRequest
 }
 
 func (d *AutoTagDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
+	if providerData := dataSourceConfigureData(ctx, req, resp); providerData != nil {
+		d.client = providerData.Client
+		d.auth = providerData.Auth
+		d.version = providerData.Version
 	}
 }
 
 func (d *AutoTagDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	helpers.CheckVersionRequirements(ctx, "sonarr_"+autoTagDataSourceName, d.version, req.Config, autoTagVersionRequirements, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data *AutoTag
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -102,7 +111,7 @@ func (d *AutoTagDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	// Get autoTag current value
-	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(d.auth).Execute()
+	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagDataSourceName, err))
 
@@ -7,6 +7,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -20,10 +21,16 @@ import (
 
 const autoTagResourceName = "auto_tag"
 
+// autoTagVersionRequirements declares the Sonarr version introducing auto tagging.
+var autoTagVersionRequirements = []helpers.VersionRequirement{
+	helpers.NewVersionRequirement("4.0.0"),
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &AutoTagResource{}
 	_ resource.ResourceWithImportState = &AutoTagResource{}
+	_ resource.ResourceWithModifyPlan  = &AutoTagResource{}
 )
 
 func NewAutoTagResource() resource.Resource {
@@ -32,8 +39,9 @@ func NewAutoTagResource() resource.Resource {
 
 // AutoTagResource defines the tag implementation.
 type AutoTagResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	version *version.Version
 }
 
 // AutoTag describes the tag data model.
@@ -131,12 +139,17 @@ func (r AutoTagResource) getSpecificationSchema() schema.Schema {
 }
 
 func (r *AutoTagResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceConfigureData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.version = providerData.Version
 	}
 }
 
+func (r *AutoTagResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.CheckVersionRequirements(ctx, "sonarr_"+autoTagResourceName, r.version, req.Config, autoTagVersionRequirements, &resp.Diagnostics)
+}
+
 func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var autoTag *AutoTag
@@ -150,9 +163,9 @@ func (r *AutoTagResource) Create(ctx context.Context, req resource.CreateRequest
 	// Create new auto tag
 	request := autoTag.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.AutoTaggingAPI.CreateAutoTagging(r.auth).AutoTaggingResource(*request).Execute()
+	response, _, err := r.client.AutoTaggingAPI.CreateAutoTagging(helpers.WithAuth(ctx, r.auth)).AutoTaggingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, autoTagResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, autoTagResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -174,9 +187,9 @@ func (r *AutoTagResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	// Get auto tag current value
-	response, _, err := r.client.AutoTaggingAPI.GetAutoTaggingById(r.auth, int32(autoTag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.AutoTaggingAPI.GetAutoTaggingById(helpers.WithAuth(ctx, r.auth), int32(autoTag.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagResourceName, err))
+		helpers.HandleReadError(ctx, autoTagResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -200,9 +213,9 @@ func (r *AutoTagResource) Update(ctx context.Context, req resource.UpdateRequest
 	// Update auto tag
 	request := autoTag.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.AutoTaggingAPI.UpdateAutoTagging(r.auth, fmt.Sprint(request.GetId())).AutoTaggingResource(*request).Execute()
+	response, _, err := r.client.AutoTaggingAPI.UpdateAutoTagging(helpers.WithAuth(ctx, r.auth), fmt.Sprint(request.GetId())).AutoTaggingResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, autoTagResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, autoTagResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -223,7 +236,7 @@ func (r *AutoTagResource) Delete(ctx context.Context, req resource.DeleteRequest
 	}
 
 	// Delete auto tag current value
-	_, err := r.client.AutoTaggingAPI.DeleteAutoTagging(r.auth, int32(ID)).Execute()
+	_, err := r.client.AutoTaggingAPI.DeleteAutoTagging(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, autoTagResourceName, err))
 
@@ -47,6 +47,19 @@ func TestAccAutoTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccTagResourceConfig("test", "autotag") + testAccAutoTagResourceConfig("Test", "true"),
+				Check:              testAccCheckResourceDisappears("sonarr_auto_tag.test", "autotagging"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccTagResourceConfig("test", "autotag") + testAccAutoTagResourceConfig("Test", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_auto_tag.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,8 +24,9 @@ func NewAutoTagsDataSource() datasource.DataSource {
 
 // AutoTagsDataSource defines the download clients implementation.
 type AutoTagsDataSource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	version *version.Version
 }
 
 // AutoTags describes the download clients data model.
@@ -105,15 +107,22 @@ func (d *AutoTagsDataSource) Schema(_ context.Context, _ datasource.SchemaReques
 }
 
 func (d *AutoTagsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
+	if providerData := dataSourceConfigureData(ctx, req, resp); providerData != nil {
+		d.client = providerData.Client
+		d.auth = providerData.Auth
+		d.version = providerData.Version
 	}
 }
 
-func (d *AutoTagsDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *AutoTagsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	helpers.CheckVersionRequirements(ctx, "sonarr_"+autoTagsDataSourceName, d.version, req.Config, autoTagVersionRequirements, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get download clients current value
-	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(d.auth).Execute()
+	response, _, err := d.client.AutoTaggingAPI.ListAutoTagging(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, autoTagsDataSourceName, err))
 
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	calendarDataSourceName = "calendar"
+	calendarDefaultEnd     = "7d"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &CalendarDataSource{}
+
+func NewCalendarDataSource() datasource.DataSource {
+	return &CalendarDataSource{}
+}
+
+// CalendarDataSource defines the calendar implementation.
+type CalendarDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Calendar describes the calendar data model.
+type Calendar struct {
+	Episodes    types.Set    `tfsdk:"episodes"`
+	Tags        types.Set    `tfsdk:"tags"`
+	ID          types.String `tfsdk:"id"`
+	Start       types.String `tfsdk:"start"`
+	End         types.String `tfsdk:"end"`
+	Unmonitored types.Bool   `tfsdk:"unmonitored"`
+}
+
+// CalendarEpisode describes the calendar episode data model.
+type CalendarEpisode struct {
+	SeriesTitle   types.String `tfsdk:"series_title"`
+	Title         types.String `tfsdk:"title"`
+	AirDate       types.String `tfsdk:"air_date"`
+	AirDateUtc    types.String `tfsdk:"air_date_utc"`
+	ID            types.Int64  `tfsdk:"id"`
+	SeriesID      types.Int64  `tfsdk:"series_id"`
+	SeasonNumber  types.Int64  `tfsdk:"season_number"`
+	EpisodeNumber types.Int64  `tfsdk:"episode_number"`
+	HasFile       types.Bool   `tfsdk:"has_file"`
+	Monitored     types.Bool   `tfsdk:"monitored"`
+}
+
+func (e CalendarEpisode) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"series_title":   types.StringType,
+			"title":          types.StringType,
+			"air_date":       types.StringType,
+			"air_date_utc":   types.StringType,
+			"id":             types.Int64Type,
+			"series_id":      types.Int64Type,
+			"season_number":  types.Int64Type,
+			"episode_number": types.Int64Type,
+			"has_file":       types.BoolType,
+			"monitored":      types.BoolType,
+		})
+}
+
+func (d *CalendarDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + calendarDataSourceName
+}
+
+func (d *CalendarDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nList the [Episodes](../data-sources/episode) airing in a time window, like the Sonarr calendar.\n" +
+			"Window bounds are RFC3339 timestamps or durations relative to now, e.g. `-1d` or `7d12h`.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"start": schema.StringAttribute{
+				MarkdownDescription: "Window start. Defaults to now.",
+				Optional:            true,
+			},
+			"end": schema.StringAttribute{
+				MarkdownDescription: "Window end. Defaults to `" + calendarDefaultEnd + "` after `start`.",
+				Optional:            true,
+			},
+			"unmonitored": schema.BoolAttribute{
+				MarkdownDescription: "Include unmonitored episodes. Defaults to `false`.",
+				Optional:            true,
+			},
+			"tags": schema.SetAttribute{
+				MarkdownDescription: "Return only the episodes of series with any of these tags.",
+				Optional:            true,
+				ElementType:         types.Int64Type,
+			},
+			"episodes": schema.SetNestedAttribute{
+				MarkdownDescription: "Episode list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Episode ID.",
+							Computed:            true,
+						},
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"series_title": schema.StringAttribute{
+							MarkdownDescription: "Series title.",
+							Computed:            true,
+						},
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Computed:            true,
+						},
+						"episode_number": schema.Int64Attribute{
+							MarkdownDescription: "Episode number.",
+							Computed:            true,
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Episode title.",
+							Computed:            true,
+						},
+						"air_date": schema.StringAttribute{
+							MarkdownDescription: "Air date in the series network time zone, in `YYYY-MM-DD` format.",
+							Computed:            true,
+						},
+						"air_date_utc": schema.StringAttribute{
+							MarkdownDescription: "Air date and time in UTC, in RFC3339 format.",
+							Computed:            true,
+						},
+						"has_file": schema.BoolAttribute{
+							MarkdownDescription: "Has file flag.",
+							Computed:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *CalendarDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *CalendarDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Calendar
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Resolve the time window
+	now := time.Now()
+	start := data.parseTime(path.Root("start"), data.Start.ValueString(), now, &resp.Diagnostics)
+	end := data.parseTime(path.Root("end"), data.End.ValueString(), now, &resp.Diagnostics)
+
+	if data.End.IsNull() {
+		end = data.parseTime(path.Root("end"), calendarDefaultEnd, start, &resp.Diagnostics)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get calendar current value
+	request := d.client.CalendarAPI.ListCalendar(helpers.WithAuth(ctx, d.auth)).
+		Start(start).
+		End(end).
+		Unmonitored(data.Unmonitored.ValueBool()).
+		IncludeSeries(true)
+
+	if tags := data.readTags(ctx, &resp.Diagnostics); tags != "" {
+		request = request.Tags(tags)
+	}
+
+	response, _, err := request.Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, calendarDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+calendarDataSourceName)
+	// Map response body to resource schema attribute
+	episodes := make([]CalendarEpisode, len(response))
+	for i, e := range response {
+		episodes[i].write(&e)
+	}
+
+	episodeList, diags := types.SetValueFrom(ctx, CalendarEpisode{}.getType(), episodes)
+	resp.Diagnostics.Append(diags...)
+
+	data.Episodes = episodeList
+	data.ID = types.StringValue(start.UTC().Format(time.RFC3339) + "/" + end.UTC().Format(time.RFC3339))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// parseTime resolves a window bound, null values default to now.
+func (c *Calendar) parseTime(attribute path.Path, value string, now time.Time, diags *diag.Diagnostics) time.Time {
+	if value == "" {
+		return now
+	}
+
+	parsed, err := helpers.ParseRelativeTime(value, now)
+	if err != nil {
+		diags.AddAttributeError(attribute, helpers.DataSourceError, err.Error())
+	}
+
+	return parsed
+}
+
+// readTags returns the tag filter in the comma separated API format.
+func (c *Calendar) readTags(ctx context.Context, diags *diag.Diagnostics) string {
+	tags := make([]int64, 0, len(c.Tags.Elements()))
+	diags.Append(c.Tags.ElementsAs(ctx, &tags, true)...)
+
+	ids := make([]string, len(tags))
+	for i, tag := range tags {
+		ids[i] = strconv.FormatInt(tag, 10)
+	}
+
+	return strings.Join(ids, ",")
+}
+
+func (e *CalendarEpisode) write(episode *sonarr.EpisodeResource) {
+	e.ID = types.Int64Value(int64(episode.GetId()))
+	e.SeriesID = types.Int64Value(int64(episode.GetSeriesId()))
+	e.SeasonNumber = types.Int64Value(int64(episode.GetSeasonNumber()))
+	e.EpisodeNumber = types.Int64Value(int64(episode.GetEpisodeNumber()))
+	e.Title = types.StringValue(episode.GetTitle())
+	e.SeriesTitle = types.StringValue(episode.GetSeriesTitle())
+	e.HasFile = types.BoolValue(episode.GetHasFile())
+	e.Monitored = types.BoolValue(episode.GetMonitored())
+	e.AirDate = types.StringNull()
+	e.AirDateUtc = types.StringNull()
+
+	if series, ok := episode.GetSeriesOk(); ok {
+		e.SeriesTitle = types.StringValue(series.GetTitle())
+	}
+
+	if episode.GetAirDate() != "" {
+		e.AirDate = types.StringValue(episode.GetAirDate())
+	}
+
+	if episode.AirDateUtc.IsSet() && episode.AirDateUtc.Get() != nil {
+		e.AirDateUtc = types.StringValue(episode.GetAirDateUtc().UTC().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,98 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccCalendarDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccCalendarDataSourceConfig(`start = "-1d"`) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid window
+			{
+				Config:      testAccCalendarDataSourceConfig(`start = "yesterday"`),
+				ExpectError: regexp.MustCompile("invalid time"),
+			},
+			// Read testing
+			{
+				Config: testAccCalendarDataSourceSeriesConfig + testAccCalendarDataSourceConfig(`
+					start       = "2009-09-01T00:00:00Z"
+					end         = "2009-09-10T00:00:00Z"
+					unmonitored = true
+					tags        = sonarr_series.calendar.tags
+				`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_calendar.test", "episodes.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_calendar.test", "episodes.*", map[string]string{
+						"series_title":   "Castle",
+						"title":          "Pilot",
+						"season_number":  "1",
+						"episode_number": "1",
+						"air_date":       "2009-09-01",
+						"air_date_utc":   "2009-09-01T01:00:00Z",
+						"has_file":       "true",
+						"monitored":      "false",
+					}),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_calendar.test", "episodes.*", map[string]string{
+						"title":    "Episode 2",
+						"has_file": "false",
+					}),
+				),
+			},
+			// Filter testing
+			{
+				Config: testAccCalendarDataSourceSeriesConfig + testAccCalendarDataSourceConfig(`
+					start = "2009-09-01T00:00:00Z"
+					end   = "2009-09-10T00:00:00Z"
+					tags  = sonarr_series.calendar.tags
+				`),
+				Check: resource.TestCheckResourceAttr("data.sonarr_calendar.test", "episodes.#", "0"),
+			},
+			{
+				Config: testAccCalendarDataSourceSeriesConfig + testAccCalendarDataSourceConfig(`
+					start       = "-1d"
+					unmonitored = true
+					tags        = sonarr_series.calendar.tags
+				`),
+				Check: resource.TestCheckResourceAttr("data.sonarr_calendar.test", "episodes.#", "0"),
+			},
+		},
+	})
+}
+
+const testAccCalendarDataSourceSeriesConfig = `
+resource "sonarr_tag" "calendar" {
+	label = "calendar"
+}
+
+resource "sonarr_series" "calendar" {
+	tvdb_id            = 83462
+	root_folder_path   = "/config"
+	quality_profile_id = 1
+	tags               = [sonarr_tag.calendar.id]
+
+	monitored           = false
+	season_folder       = true
+	use_scene_numbering = false
+}
+`
+
+func testAccCalendarDataSourceConfig(filter string) string {
+	return fmt.Sprintf(`
+	data "sonarr_calendar" "test" {
+		%s
+	}
+	`, filter)
+}
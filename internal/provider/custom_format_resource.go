@@ -151,9 +151,9 @@ func (r *CustomFormatResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(r.auth).CustomFormatResource(*request).Execute()
+	response, _, err := r.client.CustomFormatAPI.CreateCustomFormat(helpers.WithAuth(ctx, r.auth)).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, customFormatResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, customFormatResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -178,9 +178,9 @@ func (r *CustomFormatResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get CustomFormat current value
-	response, _, err := r.client.CustomFormatAPI.GetCustomFormatById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.CustomFormatAPI.GetCustomFormatById(helpers.WithAuth(ctx, r.auth), int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, customFormatResourceName, err))
+		helpers.HandleReadError(ctx, customFormatResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -207,9 +207,9 @@ func (r *CustomFormatResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update CustomFormat
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(r.auth, strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
+	response, _, err := r.client.CustomFormatAPI.UpdateCustomFormat(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).CustomFormatResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, customFormatResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, customFormatResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -233,7 +233,7 @@ func (r *CustomFormatResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete CustomFormat current value
-	_, err := r.client.CustomFormatAPI.DeleteCustomFormat(r.auth, int32(ID)).Execute()
+	_, err := r.client.CustomFormatAPI.DeleteCustomFormat(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, customFormatResourceName, err))
 
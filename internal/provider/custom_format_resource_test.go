@@ -46,6 +46,19 @@ func TestAccCustomFormatResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccCustomFormatResourceConfig("resourceTest", "true"),
+				Check:              testAccCheckResourceDisappears("sonarr_custom_format.test", "customformat"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccCustomFormatResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_custom_format.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -162,9 +162,9 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	request := profile.read(ctx, &resp.Diagnostics)
 
 	// Create new DelayProfile
-	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(r.auth).DelayProfileResource(*request).Execute()
+	response, _, err := r.client.DelayProfileAPI.CreateDelayProfile(helpers.WithAuth(ctx, r.auth)).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, delayProfileResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, delayProfileResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -175,9 +175,9 @@ func (r *DelayProfileResource) Create(ctx context.Context, req resource.CreateRe
 	if !profile.Order.IsUnknown() {
 		response.Order = request.Order
 
-		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
+		response, _, err = r.client.DelayProfileAPI.UpdateDelayProfile(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(response.GetId()))).DelayProfileResource(*response).Execute()
 		if err != nil {
-			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+			helpers.HandleWriteError(ctx, helpers.Update, delayProfileResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 			return
 		}
@@ -199,9 +199,9 @@ func (r *DelayProfileResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get delayprofile current value
-	response, _, err := r.client.DelayProfileAPI.GetDelayProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DelayProfileAPI.GetDelayProfileById(helpers.WithAuth(ctx, r.auth), int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, delayProfileResourceName, err))
+		helpers.HandleReadError(ctx, delayProfileResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -226,9 +226,9 @@ func (r *DelayProfileResource) Update(ctx context.Context, req resource.UpdateRe
 	request := profile.read(ctx, &resp.Diagnostics)
 
 	// Update DelayProfile
-	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(r.auth, strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
+	response, _, err := r.client.DelayProfileAPI.UpdateDelayProfile(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).DelayProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, delayProfileResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, delayProfileResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -249,7 +249,7 @@ func (r *DelayProfileResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete delayprofile current value
-	_, err := r.client.DelayProfileAPI.DeleteDelayProfile(r.auth, int32(ID)).Execute()
+	_, err := r.client.DelayProfileAPI.DeleteDelayProfile(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, delayProfileResourceName, err))
 
@@ -46,6 +46,19 @@ func TestAccDelayProfileResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccTagResourceConfig("test", "delay_profile_resource") + testAccDelayProfileResourceConfig("torrent", "sonarr_tag.test.id"),
+				Check:              testAccCheckResourceDisappears("sonarr_delay_profile.test", "delayprofile"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccTagResourceConfig("test", "delay_profile_resource") + testAccDelayProfileResourceConfig("torrent", "sonarr_tag.test.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_delay_profile.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -186,9 +186,9 @@ func (r *DownloadClientAria2Resource) Create(ctx context.Context, req resource.C
 	// Create new DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(helpers.WithAuth(ctx, r.auth)).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientAria2ResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, downloadClientAria2ResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -210,9 +210,9 @@ func (r *DownloadClientAria2Resource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientAria2 current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(helpers.WithAuth(ctx, r.auth), int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientAria2ResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientAria2ResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -236,9 +236,9 @@ func (r *DownloadClientAria2Resource) Update(ctx context.Context, req resource.U
 	// Update DownloadClientAria2
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientAria2ResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, downloadClientAria2ResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -259,7 +259,7 @@ func (r *DownloadClientAria2Resource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete DownloadClientAria2 current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientAria2ResourceName, err))
 
@@ -94,9 +94,9 @@ func (r *DownloadClientConfigResource) Create(ctx context.Context, req resource.
 	request.SetId(1)
 
 	// Create new DownloadClientConfig
-	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientConfigResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, downloadClientConfigResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -118,7 +118,7 @@ func (r *DownloadClientConfigResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get downloadClientConfig current value
-	response, _, err := r.client.DownloadClientConfigAPI.GetDownloadClientConfig(r.auth).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.GetDownloadClientConfig(helpers.WithAuth(ctx, r.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientConfigResourceName, err))
 
@@ -145,9 +145,9 @@ func (r *DownloadClientConfigResource) Update(ctx context.Context, req resource.
 	request := config.read()
 
 	// Update DownloadClientConfig
-	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
+	response, _, err := r.client.DownloadClientConfigAPI.UpdateDownloadClientConfig(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).DownloadClientConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientConfigResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, downloadClientConfigResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
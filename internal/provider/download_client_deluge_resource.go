@@ -233,9 +233,9 @@ func (r *DownloadClientDelugeResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(helpers.WithAuth(ctx, r.auth)).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientDelugeResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, downloadClientDelugeResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -257,9 +257,9 @@ func (r *DownloadClientDelugeResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientDeluge current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(helpers.WithAuth(ctx, r.auth), int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientDelugeResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientDelugeResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -283,9 +283,9 @@ func (r *DownloadClientDelugeResource) Update(ctx context.Context, req resource.
 	// Update DownloadClientDeluge
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientDelugeResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, downloadClientDelugeResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -306,7 +306,7 @@ func (r *DownloadClientDelugeResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete DownloadClientDeluge current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientDelugeResourceName, err))
 
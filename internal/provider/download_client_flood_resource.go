@@ -237,9 +237,9 @@ func (r *DownloadClientFloodResource) Create(ctx context.Context, req resource.C
 	// Create new DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(helpers.WithAuth(ctx, r.auth)).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientFloodResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, downloadClientFloodResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -261,9 +261,9 @@ func (r *DownloadClientFloodResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get DownloadClientFlood current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(helpers.WithAuth(ctx, r.auth), int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientFloodResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientFloodResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -287,9 +287,9 @@ func (r *DownloadClientFloodResource) Update(ctx context.Context, req resource.U
 	// Update DownloadClientFlood
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientFloodResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, downloadClientFloodResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -310,7 +310,7 @@ func (r *DownloadClientFloodResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete DownloadClientFlood current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientFloodResourceName, err))
 
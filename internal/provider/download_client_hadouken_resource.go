@@ -200,9 +200,9 @@ func (r *DownloadClientHadoukenResource) Create(ctx context.Context, req resourc
 	// Create new DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(helpers.WithAuth(ctx, r.auth)).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientHadoukenResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, downloadClientHadoukenResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -224,9 +224,9 @@ func (r *DownloadClientHadoukenResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get DownloadClientHadouken current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(helpers.WithAuth(ctx, r.auth), int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientHadoukenResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientHadoukenResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -250,9 +250,9 @@ func (r *DownloadClientHadoukenResource) Update(ctx context.Context, req resourc
 	// Update DownloadClientHadouken
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientHadoukenResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, downloadClientHadoukenResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -273,7 +273,7 @@ func (r *DownloadClientHadoukenResource) Delete(ctx context.Context, req resourc
 	}
 
 	// Delete DownloadClientHadouken current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientHadoukenResourceName, err))
 
@@ -234,9 +234,9 @@ func (r *DownloadClientNzbgetResource) Create(ctx context.Context, req resource.
 	// Create new DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(r.auth).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.CreateDownloadClient(helpers.WithAuth(ctx, r.auth)).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, downloadClientNzbgetResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, downloadClientNzbgetResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -258,9 +258,9 @@ func (r *DownloadClientNzbgetResource) Read(ctx context.Context, req resource.Re
 	}
 
 	// Get DownloadClientNzbget current value
-	response, _, err := r.client.DownloadClientAPI.GetDownloadClientById(r.auth, int32(client.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.DownloadClientAPI.GetDownloadClientById(helpers.WithAuth(ctx, r.auth), int32(client.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, downloadClientNzbgetResourceName, err))
+		helpers.HandleReadError(ctx, downloadClientNzbgetResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -284,9 +284,9 @@ func (r *DownloadClientNzbgetResource) Update(ctx context.Context, req resource.
 	// Update DownloadClientNzbget
 	request := client.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(r.auth, strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
+	response, _, err := r.client.DownloadClientAPI.UpdateDownloadClient(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).DownloadClientResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, downloadClientNzbgetResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, downloadClientNzbgetResourceName, err, downloadClientFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -307,7 +307,7 @@ func (r *DownloadClientNzbgetResource) Delete(ctx context.Context, req resource.
 	}
 
 	// Delete DownloadClientNzbget current value
-	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(r.auth, int32(ID)).Execute()
+	_, err := r.client.DownloadClientAPI.DeleteDownloadClient(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, downloadClientNzbgetResourceName, err))
 
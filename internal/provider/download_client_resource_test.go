@@ -53,6 +53,19 @@ func TestAccDownloadClientResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"password"},
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccDownloadClientResourceConfig("resourceTest", "true"),
+				Check:              testAccCheckResourceDisappears("sonarr_download_client.test", "downloadclient"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccDownloadClientResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_download_client.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -20,6 +20,11 @@ func TestAccDownloadClientTransmissionResource(t *testing.T) {
 				Config:      testAccDownloadClientTransmissionResourceConfig("resourceTransmissionTest", "false") + testUnauthorizedProvider,
 				ExpectError: regexp.MustCompile("Client Error"),
 			},
+			// Validation error testing
+			{
+				Config:      testAccDownloadClientTransmissionResourceConfigHost("resourceTransmissionTest", "invalid host"),
+				ExpectError: regexp.MustCompile(`(?s)host\s+= "invalid host".*got validation error: 'Host'\s+must be valid Host`),
+			},
 			// Create and Read testing
 			{
 				Config: testAccDownloadClientTransmissionResourceConfig("resourceTransmissionTest", "false"),
@@ -52,6 +57,18 @@ func TestAccDownloadClientTransmissionResource(t *testing.T) {
 	})
 }
 
+func testAccDownloadClientTransmissionResourceConfigHost(name, host string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_download_client_transmission" "test" {
+		enable = false
+		priority = 1
+		name = "%s"
+		host = "%s"
+		url_base = "/transmission/"
+		port = 9091
+	}`, name, host)
+}
+
 func testAccDownloadClientTransmissionResourceConfig(name, enable string) string {
 	return fmt.Sprintf(`
 	resource "sonarr_download_client_transmission" "test" {
@@ -0,0 +1,243 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const episodeDataSourceName = "episode"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EpisodeDataSource{}
+
+func NewEpisodeDataSource() datasource.DataSource {
+	return &EpisodeDataSource{}
+}
+
+// EpisodeDataSource defines the episode implementation.
+type EpisodeDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// Episode describes the episode data model.
+type Episode struct {
+	Title                 types.String `tfsdk:"title"`
+	Overview              types.String `tfsdk:"overview"`
+	AirDate               types.String `tfsdk:"air_date"`
+	AirDateUtc            types.String `tfsdk:"air_date_utc"`
+	ID                    types.Int64  `tfsdk:"id"`
+	SeriesID              types.Int64  `tfsdk:"series_id"`
+	TvdbID                types.Int64  `tfsdk:"tvdb_id"`
+	EpisodeFileID         types.Int64  `tfsdk:"episode_file_id"`
+	SeasonNumber          types.Int64  `tfsdk:"season_number"`
+	EpisodeNumber         types.Int64  `tfsdk:"episode_number"`
+	AbsoluteEpisodeNumber types.Int64  `tfsdk:"absolute_episode_number"`
+	Runtime               types.Int64  `tfsdk:"runtime"`
+	HasFile               types.Bool   `tfsdk:"has_file"`
+	Monitored             types.Bool   `tfsdk:"monitored"`
+}
+
+func (e Episode) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"title":                   types.StringType,
+			"overview":                types.StringType,
+			"air_date":                types.StringType,
+			"air_date_utc":            types.StringType,
+			"id":                      types.Int64Type,
+			"series_id":               types.Int64Type,
+			"tvdb_id":                 types.Int64Type,
+			"episode_file_id":         types.Int64Type,
+			"season_number":           types.Int64Type,
+			"episode_number":          types.Int64Type,
+			"absolute_episode_number": types.Int64Type,
+			"runtime":                 types.Int64Type,
+			"has_file":                types.BoolType,
+			"monitored":               types.BoolType,
+		})
+}
+
+func (d *EpisodeDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + episodeDataSourceName
+}
+
+func (d *EpisodeDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nSingle Episode of a [Series](../resources/series).\nThe episode is identified either by `id` or by `series_id`, `season_number` and `episode_number`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Episode ID.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.ExactlyOneOf(path.MatchRoot("series_id")),
+				},
+			},
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("season_number"), path.MatchRoot("episode_number")),
+				},
+			},
+			"season_number": schema.Int64Attribute{
+				MarkdownDescription: "Season number.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("series_id")),
+				},
+			},
+			"episode_number": schema.Int64Attribute{
+				MarkdownDescription: "Episode number.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.AlsoRequires(path.MatchRoot("series_id")),
+				},
+			},
+			"absolute_episode_number": schema.Int64Attribute{
+				MarkdownDescription: "Absolute episode number.",
+				Computed:            true,
+			},
+			"tvdb_id": schema.Int64Attribute{
+				MarkdownDescription: "TVDB ID.",
+				Computed:            true,
+			},
+			"episode_file_id": schema.Int64Attribute{
+				MarkdownDescription: "Episode file ID, `0` if the episode has no file.",
+				Computed:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Episode title.",
+				Computed:            true,
+			},
+			"overview": schema.StringAttribute{
+				MarkdownDescription: "Overview.",
+				Computed:            true,
+			},
+			"air_date": schema.StringAttribute{
+				MarkdownDescription: "Air date in the series network time zone, in `YYYY-MM-DD` format.",
+				Computed:            true,
+			},
+			"air_date_utc": schema.StringAttribute{
+				MarkdownDescription: "Air date and time in UTC, in RFC3339 format.",
+				Computed:            true,
+			},
+			"runtime": schema.Int64Attribute{
+				MarkdownDescription: "Runtime in minutes.",
+				Computed:            true,
+			},
+			"has_file": schema.BoolAttribute{
+				MarkdownDescription: "Has file flag.",
+				Computed:            true,
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *EpisodeDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *EpisodeDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *Episode
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get episode current value
+	if !data.ID.IsNull() {
+		response, _, err := d.client.EpisodeAPI.GetEpisodeById(helpers.WithAuth(ctx, d.auth), int32(data.ID.ValueInt64())).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, episodeDataSourceName, err))
+
+			return
+		}
+
+		data.write(response)
+	} else {
+		response, _, err := d.client.EpisodeAPI.ListEpisode(helpers.WithAuth(ctx, d.auth)).
+			SeriesId(int32(data.SeriesID.ValueInt64())).
+			SeasonNumber(int32(data.SeasonNumber.ValueInt64())).
+			Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, episodeDataSourceName, err))
+
+			return
+		}
+
+		data.find(data.EpisodeNumber.ValueInt64(), response, &resp.Diagnostics)
+	}
+
+	tflog.Trace(ctx, "read "+episodeDataSourceName)
+	// Map response body to resource schema attribute
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (e *Episode) find(number int64, episodes []sonarr.EpisodeResource, diags *diag.Diagnostics) {
+	for _, episode := range episodes {
+		if int64(episode.GetEpisodeNumber()) == number {
+			e.write(&episode)
+
+			return
+		}
+	}
+
+	diags.AddError(helpers.DataSourceError, helpers.ParseNotFoundError(episodeDataSourceName, "episode",
+		fmt.Sprintf("S%02dE%02d of series %d", e.SeasonNumber.ValueInt64(), number, e.SeriesID.ValueInt64())))
+}
+
+func (e *Episode) write(episode *sonarr.EpisodeResource) {
+	e.ID = types.Int64Value(int64(episode.GetId()))
+	e.SeriesID = types.Int64Value(int64(episode.GetSeriesId()))
+	e.TvdbID = types.Int64Value(int64(episode.GetTvdbId()))
+	e.EpisodeFileID = types.Int64Value(int64(episode.GetEpisodeFileId()))
+	e.SeasonNumber = types.Int64Value(int64(episode.GetSeasonNumber()))
+	e.EpisodeNumber = types.Int64Value(int64(episode.GetEpisodeNumber()))
+	e.Title = types.StringValue(episode.GetTitle())
+	e.Overview = types.StringValue(episode.GetOverview())
+	e.Runtime = types.Int64Value(int64(episode.GetRuntime()))
+	e.HasFile = types.BoolValue(episode.GetHasFile())
+	e.Monitored = types.BoolValue(episode.GetMonitored())
+	e.AbsoluteEpisodeNumber = types.Int64Null()
+	e.AirDate = types.StringNull()
+	e.AirDateUtc = types.StringNull()
+
+	if episode.AbsoluteEpisodeNumber.IsSet() && episode.AbsoluteEpisodeNumber.Get() != nil {
+		e.AbsoluteEpisodeNumber = types.Int64Value(int64(episode.GetAbsoluteEpisodeNumber()))
+	}
+
+	if episode.GetAirDate() != "" {
+		e.AirDate = types.StringValue(episode.GetAirDate())
+	}
+
+	if episode.AirDateUtc.IsSet() && episode.AirDateUtc.Get() != nil {
+		e.AirDateUtc = types.StringValue(episode.GetAirDateUtc().UTC().Format(time.RFC3339))
+	}
+}
@@ -0,0 +1,74 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEpisodeDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccEpisodeDataSourceConfig("id = 999999") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid identifiers testing
+			{
+				Config:      testAccEpisodeDataSourceConfig("id = 1\nseries_id = 1\nseason_number = 1\nepisode_number = 1"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Combination"),
+			},
+			// Not found testing
+			{
+				Config:      testAccEpisodeDataSourceSeriesConfig + testAccEpisodeDataSourceConfig("series_id = sonarr_series.episode.id\nseason_number = 1\nepisode_number = 99"),
+				ExpectError: regexp.MustCompile("Unable to find episode"),
+			},
+			// Read testing
+			{
+				Config: testAccEpisodeDataSourceSeriesConfig + testAccEpisodeDataSourceConfig("series_id = sonarr_series.episode.id\nseason_number = 1\nepisode_number = 1") + `
+				data "sonarr_episode" "by_id" {
+					id = data.sonarr_episode.test.id
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_episode.test", "title", "Pilot"),
+					resource.TestCheckResourceAttr("data.sonarr_episode.test", "absolute_episode_number", "1"),
+					resource.TestCheckResourceAttr("data.sonarr_episode.test", "air_date", "2004-09-01"),
+					resource.TestCheckResourceAttr("data.sonarr_episode.test", "has_file", "true"),
+					resource.TestCheckResourceAttrSet("data.sonarr_episode.test", "episode_file_id"),
+					resource.TestCheckResourceAttrPair("data.sonarr_episode.by_id", "title", "data.sonarr_episode.test", "title"),
+					resource.TestCheckResourceAttrPair("data.sonarr_episode.by_id", "series_id", "sonarr_series.episode", "id"),
+					resource.TestCheckResourceAttr("data.sonarr_episode.by_id", "season_number", "1"),
+					resource.TestCheckResourceAttr("data.sonarr_episode.by_id", "episode_number", "1"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEpisodeDataSourceSeriesConfig = `
+resource "sonarr_series" "episode" {
+	tvdb_id            = 78901
+	root_folder_path   = "/config"
+	quality_profile_id = 1
+
+	monitored           = true
+	season_folder       = true
+	use_scene_numbering = false
+}
+`
+
+func testAccEpisodeDataSourceConfig(filter string) string {
+	return fmt.Sprintf(`
+	data "sonarr_episode" "test" {
+		%s
+	}
+	`, filter)
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const episodeFileQualityResourceName = "episode_file_quality"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &EpisodeFileQualityResource{}
+	_ resource.ResourceWithImportState = &EpisodeFileQualityResource{}
+)
+
+func NewEpisodeFileQualityResource() resource.Resource {
+	return &EpisodeFileQualityResource{}
+}
+
+// EpisodeFileQualityResource defines the episode file quality implementation.
+type EpisodeFileQualityResource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// EpisodeFileQuality describes the episode file quality data model.
+type EpisodeFileQuality struct {
+	Languages     types.Set    `tfsdk:"languages"`
+	Quality       types.String `tfsdk:"quality"`
+	ID            types.Int64  `tfsdk:"id"`
+	EpisodeFileID types.Int64  `tfsdk:"episode_file_id"`
+}
+
+func (r *EpisodeFileQualityResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + episodeFileQualityResourceName
+}
+
+func (r *EpisodeFileQualityResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	qualities := make([]string, len(defaultQualities))
+	for i, quality := range defaultQualities {
+		qualities[i] = quality.name
+	}
+
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nEpisode File Quality resource.\nOverrides the quality and languages Sonarr assigned to an [Episode File](../data-sources/episode_files). " +
+			"Destroying the resource leaves the episode file unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.Int64Attribute{
+				MarkdownDescription: "Episode File Quality ID, same as the episode file ID.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"episode_file_id": schema.Int64Attribute{
+				MarkdownDescription: "Episode File ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"quality": schema.StringAttribute{
+				MarkdownDescription: "Quality name, e.g. `WEBDL-1080p`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(qualities...),
+				},
+			},
+			"languages": schema.SetAttribute{
+				MarkdownDescription: "Language names as listed by the [Languages](../data-sources/languages) data source, e.g. `English`. If unset, the languages are not changed.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *EpisodeFileQualityResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *EpisodeFileQualityResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var quality *EpisodeFileQuality
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &quality)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Override episode file quality
+	response := r.apply(ctx, helpers.Create, quality, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+episodeFileQualityResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Generate resource state struct
+	quality.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &quality)...)
+}
+
+func (r *EpisodeFileQualityResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var quality *EpisodeFileQuality
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &quality)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get episode file current value
+	response, httpResp, err := r.client.EpisodeFileAPI.GetEpisodeFileById(helpers.WithAuth(ctx, r.auth), int32(quality.ID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, episodeFileQualityResourceName, httpResp, err, resp)
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+episodeFileQualityResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	quality.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &quality)...)
+}
+
+func (r *EpisodeFileQualityResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var quality *EpisodeFileQuality
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &quality)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Override episode file quality
+	response := r.apply(ctx, helpers.Update, quality, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+episodeFileQualityResourceName+": "+strconv.Itoa(int(response.GetId())))
+	// Map response body to resource schema attribute
+	quality.write(ctx, response, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &quality)...)
+}
+
+func (r *EpisodeFileQualityResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID int64
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Episode file quality cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+episodeFileQualityResourceName+": "+strconv.Itoa(int(ID)))
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *EpisodeFileQualityResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	helpers.ImportStatePassthroughIntID(ctx, path.Root("id"), req, resp)
+	tflog.Trace(ctx, "imported "+episodeFileQualityResourceName+": "+req.ID)
+}
+
+// apply sets quality and languages through the episode file editor, keeping the current quality revision.
+func (r *EpisodeFileQualityResource) apply(ctx context.Context, action string, quality *EpisodeFileQuality, diags *diag.Diagnostics) *sonarr.EpisodeFileResource {
+	id := int32(quality.EpisodeFileID.ValueInt64())
+
+	current, _, err := r.client.EpisodeFileAPI.GetEpisodeFileById(helpers.WithAuth(ctx, r.auth), id).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, episodeFileQualityResourceName, err))
+
+		return nil
+	}
+
+	request := sonarr.NewEpisodeFileListResource()
+	request.SetEpisodeFileIds([]int32{id})
+	request.SetQuality(*quality.read(current.GetQuality()))
+
+	if !quality.Languages.IsUnknown() {
+		// Resolve the language names to the IDs known by this Sonarr instance
+		available, _, err := r.client.LanguageAPI.ListLanguage(helpers.WithAuth(ctx, r.auth)).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, episodeFileQualityResourceName, err))
+
+			return nil
+		}
+
+		request.SetLanguages(quality.readLanguages(ctx, available, diags))
+
+		if diags.HasError() {
+			return nil
+		}
+	}
+
+	if _, err := r.client.EpisodeFileAPI.PutEpisodeFileEditor(helpers.WithAuth(ctx, r.auth)).EpisodeFileListResource(*request).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, episodeFileQualityResourceName, err))
+
+		return nil
+	}
+
+	// Read back the episode file
+	response, _, err := r.client.EpisodeFileAPI.GetEpisodeFileById(helpers.WithAuth(ctx, r.auth), id).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, episodeFileQualityResourceName, err))
+
+		return nil
+	}
+
+	return response
+}
+
+func (q *EpisodeFileQuality) write(ctx context.Context, file *sonarr.EpisodeFileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	quality := file.GetQuality()
+
+	q.ID = types.Int64Value(int64(file.GetId()))
+	q.EpisodeFileID = types.Int64Value(int64(file.GetId()))
+	q.Quality = types.StringValue(quality.Quality.GetName())
+	q.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languageNames(file.GetLanguages()))
+	diags.Append(tempDiag...)
+}
+
+// read builds the quality model from the built-in qualities.
+func (q *EpisodeFileQuality) read(current sonarr.QualityModel) *sonarr.QualityModel {
+	model := sonarr.NewQualityModel()
+	model.SetRevision(current.GetRevision())
+
+	for _, definition := range defaultQualityDefinitions() {
+		if definition.GetTitle() == q.Quality.ValueString() {
+			model.SetQuality(definition.GetQuality())
+		}
+	}
+
+	return model
+}
+
+// readLanguages maps the language names to the available languages.
+func (q *EpisodeFileQuality) readLanguages(ctx context.Context, available []sonarr.LanguageResource, diags *diag.Diagnostics) []sonarr.Language {
+	names := make([]string, 0, len(q.Languages.Elements()))
+	diags.Append(q.Languages.ElementsAs(ctx, &names, true)...)
+	slices.Sort(names)
+
+	languages := make([]sonarr.Language, 0, len(names))
+
+	for _, name := range names {
+		index := slices.IndexFunc(available, func(l sonarr.LanguageResource) bool { return l.GetName() == name })
+		if index < 0 {
+			diags.AddAttributeError(path.Root("languages"), helpers.ResourceError,
+				fmt.Sprintf("Unable to find language '%s', refer to the sonarr_languages data source for the available names", name))
+
+			continue
+		}
+
+		language := sonarr.NewLanguage()
+		language.SetId(available[index].GetId())
+		language.SetName(available[index].GetName())
+		languages = append(languages, *language)
+	}
+
+	return languages
+}
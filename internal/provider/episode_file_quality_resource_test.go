@@ -0,0 +1,96 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEpisodeFileQualityResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid quality testing
+			{
+				Config:      testAccEpisodeFileQualityResourceConfig("HDTV-9000p", `languages = ["English"]`),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Invalid language testing
+			{
+				Config:      testAccEpisodeFileQualityResourceConfig("WEBDL-1080p", `languages = ["Klingon"]`),
+				ExpectError: regexp.MustCompile("Unable to find language 'Klingon'"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccEpisodeFileQualityResourceConfig("WEBDL-1080p", `languages = ["English", "Italian"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_episode_file_quality.test", "quality", "WEBDL-1080p"),
+					resource.TestCheckResourceAttr("sonarr_episode_file_quality.test", "languages.#", "2"),
+					resource.TestCheckResourceAttrPair("sonarr_episode_file_quality.test", "id", "data.sonarr_episode.pilot", "episode_file_id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccEpisodeFileQualityResourceConfig("WEBDL-1080p", `languages = ["English", "Italian"]`) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccEpisodeFileQualityResourceConfig("Bluray-1080p", ""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_episode_file_quality.test", "quality", "Bluray-1080p"),
+					resource.TestCheckResourceAttr("sonarr_episode_file_quality.test", "languages.#", "2"),
+				),
+			},
+			{
+				Config: testAccEpisodeFileQualityResourceConfig("Bluray-1080p", "") + `
+				data "sonarr_episode_files" "test" {
+					series_id = sonarr_series.file_quality.id
+				}
+				`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.quality", "Bluray-1080p"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.quality_id", "7"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.languages.#", "2"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_episode_file_quality.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccEpisodeFileQualityResourceConfig(quality, languages string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "file_quality" {
+		tvdb_id            = 248741
+		root_folder_path   = "/config"
+		quality_profile_id = 1
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+	}
+
+	data "sonarr_episode" "pilot" {
+		series_id      = sonarr_series.file_quality.id
+		season_number  = 1
+		episode_number = 1
+	}
+
+	resource "sonarr_episode_file_quality" "test" {
+		episode_file_id = data.sonarr_episode.pilot.episode_file_id
+		quality         = "%s"
+		%s
+	}
+	`, quality, languages)
+}
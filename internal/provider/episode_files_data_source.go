@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const episodeFilesDataSourceName = "episode_files"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ datasource.DataSource = &EpisodeFilesDataSource{}
+
+func NewEpisodeFilesDataSource() datasource.DataSource {
+	return &EpisodeFilesDataSource{}
+}
+
+// EpisodeFilesDataSource defines the episode files implementation.
+type EpisodeFilesDataSource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// EpisodeFiles describes the episode files data model.
+type EpisodeFiles struct {
+	EpisodeFiles types.Set    `tfsdk:"episode_files"`
+	ID           types.String `tfsdk:"id"`
+	SeriesID     types.Int64  `tfsdk:"series_id"`
+}
+
+// EpisodeFile describes the episode file data model.
+type EpisodeFile struct {
+	Languages           types.Set    `tfsdk:"languages"`
+	MediaInfo           types.Object `tfsdk:"media_info"`
+	RelativePath        types.String `tfsdk:"relative_path"`
+	Path                types.String `tfsdk:"path"`
+	DateAdded           types.String `tfsdk:"date_added"`
+	SceneName           types.String `tfsdk:"scene_name"`
+	ReleaseGroup        types.String `tfsdk:"release_group"`
+	Quality             types.String `tfsdk:"quality"`
+	ID                  types.Int64  `tfsdk:"id"`
+	SeriesID            types.Int64  `tfsdk:"series_id"`
+	SeasonNumber        types.Int64  `tfsdk:"season_number"`
+	Size                types.Int64  `tfsdk:"size"`
+	QualityID           types.Int64  `tfsdk:"quality_id"`
+	QualityVersion      types.Int64  `tfsdk:"quality_version"`
+	CustomFormatScore   types.Int64  `tfsdk:"custom_format_score"`
+	QualityCutoffNotMet types.Bool   `tfsdk:"quality_cutoff_not_met"`
+}
+
+func (e EpisodeFile) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"languages":              types.SetType{}.WithElementType(types.StringType),
+			"media_info":             MediaInfo{}.getType(),
+			"relative_path":          types.StringType,
+			"path":                   types.StringType,
+			"date_added":             types.StringType,
+			"scene_name":             types.StringType,
+			"release_group":          types.StringType,
+			"quality":                types.StringType,
+			"id":                     types.Int64Type,
+			"series_id":              types.Int64Type,
+			"season_number":          types.Int64Type,
+			"size":                   types.Int64Type,
+			"quality_id":             types.Int64Type,
+			"quality_version":        types.Int64Type,
+			"custom_format_score":    types.Int64Type,
+			"quality_cutoff_not_met": types.BoolType,
+		})
+}
+
+// MediaInfo is part of EpisodeFile.
+type MediaInfo struct {
+	AudioCodec            types.String  `tfsdk:"audio_codec"`
+	AudioLanguages        types.String  `tfsdk:"audio_languages"`
+	VideoCodec            types.String  `tfsdk:"video_codec"`
+	VideoDynamicRange     types.String  `tfsdk:"video_dynamic_range"`
+	VideoDynamicRangeType types.String  `tfsdk:"video_dynamic_range_type"`
+	Resolution            types.String  `tfsdk:"resolution"`
+	RunTime               types.String  `tfsdk:"run_time"`
+	ScanType              types.String  `tfsdk:"scan_type"`
+	Subtitles             types.String  `tfsdk:"subtitles"`
+	AudioBitrate          types.Int64   `tfsdk:"audio_bitrate"`
+	AudioStreamCount      types.Int64   `tfsdk:"audio_stream_count"`
+	VideoBitDepth         types.Int64   `tfsdk:"video_bit_depth"`
+	VideoBitrate          types.Int64   `tfsdk:"video_bitrate"`
+	AudioChannels         types.Float64 `tfsdk:"audio_channels"`
+	VideoFps              types.Float64 `tfsdk:"video_fps"`
+}
+
+func (m MediaInfo) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"audio_codec":              types.StringType,
+			"audio_languages":          types.StringType,
+			"video_codec":              types.StringType,
+			"video_dynamic_range":      types.StringType,
+			"video_dynamic_range_type": types.StringType,
+			"resolution":               types.StringType,
+			"run_time":                 types.StringType,
+			"scan_type":                types.StringType,
+			"subtitles":                types.StringType,
+			"audio_bitrate":            types.Int64Type,
+			"audio_stream_count":       types.Int64Type,
+			"video_bit_depth":          types.Int64Type,
+			"video_bitrate":            types.Int64Type,
+			"audio_channels":           types.Float64Type,
+			"video_fps":                types.Float64Type,
+		})
+}
+
+func (d *EpisodeFilesDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + episodeFilesDataSourceName
+}
+
+func (d *EpisodeFilesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nList the Episode Files of a [Series](../resources/series) on disk, with their quality and media info.",
+		Attributes: map[string]schema.Attribute{
+			// TODO: remove ID once framework support tests without ID https://www.terraform.io/plugin/framework/acctests#implement-id-attribute
+			"id": schema.StringAttribute{
+				Computed: true,
+			},
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID.",
+				Required:            true,
+			},
+			"episode_files": schema.SetNestedAttribute{
+				MarkdownDescription: "Episode File list.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Episode File ID.",
+							Computed:            true,
+						},
+						"series_id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+						},
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Computed:            true,
+						},
+						"relative_path": schema.StringAttribute{
+							MarkdownDescription: "Path relative to the series folder.",
+							Computed:            true,
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Full path.",
+							Computed:            true,
+						},
+						"size": schema.Int64Attribute{
+							MarkdownDescription: "Size in bytes.",
+							Computed:            true,
+						},
+						"date_added": schema.StringAttribute{
+							MarkdownDescription: "Date added, in RFC3339 format.",
+							Computed:            true,
+						},
+						"scene_name": schema.StringAttribute{
+							MarkdownDescription: "Scene name of the release.",
+							Computed:            true,
+						},
+						"release_group": schema.StringAttribute{
+							MarkdownDescription: "Release group.",
+							Computed:            true,
+						},
+						"quality": schema.StringAttribute{
+							MarkdownDescription: "Quality name.",
+							Computed:            true,
+						},
+						"quality_id": schema.Int64Attribute{
+							MarkdownDescription: "Quality ID.",
+							Computed:            true,
+						},
+						"quality_version": schema.Int64Attribute{
+							MarkdownDescription: "Quality revision version, greater than `1` for propers and repacks.",
+							Computed:            true,
+						},
+						"quality_cutoff_not_met": schema.BoolAttribute{
+							MarkdownDescription: "Quality cutoff not met flag.",
+							Computed:            true,
+						},
+						"custom_format_score": schema.Int64Attribute{
+							MarkdownDescription: "Custom format score.",
+							Computed:            true,
+						},
+						"languages": schema.SetAttribute{
+							MarkdownDescription: "Language names.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"media_info": schema.SingleNestedAttribute{
+							MarkdownDescription: "Media info, unset if the file was not analyzed.",
+							Computed:            true,
+							Attributes: map[string]schema.Attribute{
+								"audio_bitrate": schema.Int64Attribute{
+									MarkdownDescription: "Audio bitrate.",
+									Computed:            true,
+								},
+								"audio_channels": schema.Float64Attribute{
+									MarkdownDescription: "Audio channels.",
+									Computed:            true,
+								},
+								"audio_codec": schema.StringAttribute{
+									MarkdownDescription: "Audio codec.",
+									Computed:            true,
+								},
+								"audio_languages": schema.StringAttribute{
+									MarkdownDescription: "Audio languages, separated by `/`.",
+									Computed:            true,
+								},
+								"audio_stream_count": schema.Int64Attribute{
+									MarkdownDescription: "Audio stream count.",
+									Computed:            true,
+								},
+								"video_bit_depth": schema.Int64Attribute{
+									MarkdownDescription: "Video bit depth.",
+									Computed:            true,
+								},
+								"video_bitrate": schema.Int64Attribute{
+									MarkdownDescription: "Video bitrate.",
+									Computed:            true,
+								},
+								"video_codec": schema.StringAttribute{
+									MarkdownDescription: "Video codec.",
+									Computed:            true,
+								},
+								"video_fps": schema.Float64Attribute{
+									MarkdownDescription: "Video frames per second.",
+									Computed:            true,
+								},
+								"video_dynamic_range": schema.StringAttribute{
+									MarkdownDescription: "Video dynamic range.",
+									Computed:            true,
+								},
+								"video_dynamic_range_type": schema.StringAttribute{
+									MarkdownDescription: "Video dynamic range type.",
+									Computed:            true,
+								},
+								"resolution": schema.StringAttribute{
+									MarkdownDescription: "Video resolution, e.g. `1920x1080`.",
+									Computed:            true,
+								},
+								"run_time": schema.StringAttribute{
+									MarkdownDescription: "Run time.",
+									Computed:            true,
+								},
+								"scan_type": schema.StringAttribute{
+									MarkdownDescription: "Scan type.",
+									Computed:            true,
+								},
+								"subtitles": schema.StringAttribute{
+									MarkdownDescription: "Subtitle languages, separated by `/`.",
+									Computed:            true,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EpisodeFilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
+		d.client = client
+		d.auth = auth
+	}
+}
+
+func (d *EpisodeFilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data *EpisodeFiles
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get episode files current value
+	response, _, err := d.client.EpisodeFileAPI.ListEpisodeFile(helpers.WithAuth(ctx, d.auth)).SeriesId(int32(data.SeriesID.ValueInt64())).Execute()
+	if err != nil {
+		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, episodeFilesDataSourceName, err))
+
+		return
+	}
+
+	tflog.Trace(ctx, "read "+episodeFilesDataSourceName)
+	// Map response body to resource schema attribute
+	files := make([]EpisodeFile, len(response))
+	for i, f := range response {
+		files[i].write(ctx, &f, &resp.Diagnostics)
+	}
+
+	fileList, diags := types.SetValueFrom(ctx, EpisodeFile{}.getType(), files)
+	resp.Diagnostics.Append(diags...)
+
+	data.EpisodeFiles = fileList
+	data.ID = types.StringValue(strconv.Itoa(int(data.SeriesID.ValueInt64())))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (e *EpisodeFile) write(ctx context.Context, file *sonarr.EpisodeFileResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	quality := file.GetQuality()
+	revision := quality.GetRevision()
+
+	e.ID = types.Int64Value(int64(file.GetId()))
+	e.SeriesID = types.Int64Value(int64(file.GetSeriesId()))
+	e.SeasonNumber = types.Int64Value(int64(file.GetSeasonNumber()))
+	e.RelativePath = types.StringValue(file.GetRelativePath())
+	e.Path = types.StringValue(file.GetPath())
+	e.Size = types.Int64Value(file.GetSize())
+	e.DateAdded = types.StringValue(file.GetDateAdded().UTC().Format(time.RFC3339))
+	e.SceneName = types.StringValue(file.GetSceneName())
+	e.ReleaseGroup = types.StringValue(file.GetReleaseGroup())
+	e.Quality = types.StringValue(quality.Quality.GetName())
+	e.QualityID = types.Int64Value(int64(quality.Quality.GetId()))
+	e.QualityVersion = types.Int64Value(int64(revision.GetVersion()))
+	e.QualityCutoffNotMet = types.BoolValue(file.GetQualityCutoffNotMet())
+	e.CustomFormatScore = types.Int64Value(int64(file.GetCustomFormatScore()))
+	e.Languages, tempDiag = types.SetValueFrom(ctx, types.StringType, languageNames(file.GetLanguages()))
+	diags.Append(tempDiag...)
+	e.MediaInfo = types.ObjectNull(MediaInfo{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes())
+
+	if file.MediaInfo != nil {
+		info := MediaInfo{}
+		info.write(file.MediaInfo)
+		e.MediaInfo, tempDiag = types.ObjectValueFrom(ctx, MediaInfo{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), info)
+		diags.Append(tempDiag...)
+	}
+}
+
+func (m *MediaInfo) write(info *sonarr.MediaInfoResource) {
+	m.AudioBitrate = types.Int64Value(info.GetAudioBitrate())
+	m.AudioChannels = types.Float64Value(info.GetAudioChannels())
+	m.AudioCodec = types.StringValue(info.GetAudioCodec())
+	m.AudioLanguages = types.StringValue(info.GetAudioLanguages())
+	m.AudioStreamCount = types.Int64Value(int64(info.GetAudioStreamCount()))
+	m.VideoBitDepth = types.Int64Value(int64(info.GetVideoBitDepth()))
+	m.VideoBitrate = types.Int64Value(info.GetVideoBitrate())
+	m.VideoCodec = types.StringValue(info.GetVideoCodec())
+	m.VideoFps = types.Float64Value(info.GetVideoFps())
+	m.VideoDynamicRange = types.StringValue(info.GetVideoDynamicRange())
+	m.VideoDynamicRangeType = types.StringValue(info.GetVideoDynamicRangeType())
+	m.Resolution = types.StringValue(info.GetResolution())
+	m.RunTime = types.StringValue(info.GetRunTime())
+	m.ScanType = types.StringValue(info.GetScanType())
+	m.Subtitles = types.StringValue(info.GetSubtitles())
+}
+
+// languageNames returns the names of the languages.
+func languageNames(languages []sonarr.Language) []string {
+	names := make([]string, len(languages))
+	for i, language := range languages {
+		names[i] = language.GetName()
+	}
+
+	return names
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEpisodeFilesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccEpisodeFilesDataSourceConfig("999999") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccEpisodeFilesDataSourceSeriesConfig + testAccEpisodeFilesDataSourceConfig("sonarr_series.episode_files.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.#", "1"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.relative_path", "Season 1/NCIS - S01E01 - Pilot.mkv"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.quality", "HDTV-720p"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.size", "1073741824"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.release_group", "GROUP"),
+					resource.TestCheckTypeSetElemAttr("data.sonarr_episode_files.test", "episode_files.0.languages.*", "English"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.media_info.video_codec", "x264"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.media_info.resolution", "1280x720"),
+					resource.TestCheckResourceAttr("data.sonarr_episode_files.test", "episode_files.0.media_info.audio_channels", "2"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEpisodeFilesDataSourceSeriesConfig = `
+resource "sonarr_series" "episode_files" {
+	tvdb_id            = 72108
+	root_folder_path   = "/config"
+	quality_profile_id = 1
+
+	monitored           = false
+	season_folder       = true
+	use_scene_numbering = false
+}
+`
+
+func testAccEpisodeFilesDataSourceConfig(seriesID string) string {
+	return fmt.Sprintf(`
+	data "sonarr_episode_files" "test" {
+		series_id = %s
+	}
+	`, seriesID)
+}
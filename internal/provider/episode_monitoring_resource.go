@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const episodeMonitoringResourceName = "episode_monitoring"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &EpisodeMonitoringResource{}
+	_ resource.ResourceWithImportState = &EpisodeMonitoringResource{}
+)
+
+func NewEpisodeMonitoringResource() resource.Resource {
+	return &EpisodeMonitoringResource{}
+}
+
+// EpisodeMonitoringResource defines the episode monitoring implementation.
+type EpisodeMonitoringResource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// EpisodeMonitoring describes the episode monitoring data model.
+type EpisodeMonitoring struct {
+	EpisodeIDs types.Set    `tfsdk:"episode_ids"`
+	Episodes   types.Set    `tfsdk:"episodes"`
+	ID         types.String `tfsdk:"id"`
+	SeriesID   types.Int64  `tfsdk:"series_id"`
+	Monitored  types.Bool   `tfsdk:"monitored"`
+}
+
+// EpisodeNumber is part of EpisodeMonitoring.
+type EpisodeNumber struct {
+	SeasonNumber  types.Int64 `tfsdk:"season_number"`
+	EpisodeNumber types.Int64 `tfsdk:"episode_number"`
+}
+
+func (e EpisodeNumber) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"season_number":  types.Int64Type,
+			"episode_number": types.Int64Type,
+		})
+}
+
+func (r *EpisodeMonitoringResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + episodeMonitoringResourceName
+}
+
+func (r *EpisodeMonitoringResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nEpisode Monitoring resource.\nSets the monitored flag of some [Episodes](../data-sources/episode) of a [Series](../resources/series), identified by ID or by season and episode numbers. " +
+			"The other episodes are not managed, and destroying the resource leaves the episodes monitoring unchanged.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Episode Monitoring ID, in the `SERIES_ID:EPISODE_ID,EPISODE_ID,...` format.",
+				Computed:            true,
+			},
+			"series_id": schema.Int64Attribute{
+				MarkdownDescription: "Series ID.",
+				Required:            true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+			},
+			"monitored": schema.BoolAttribute{
+				MarkdownDescription: "Monitored flag to set on the episodes.",
+				Required:            true,
+			},
+			"episode_ids": schema.SetAttribute{
+				MarkdownDescription: "Episode IDs. Exactly one of `episode_ids` and `episodes` must be set, the other one is computed.",
+				Optional:            true,
+				Computed:            true,
+				ElementType:         types.Int64Type,
+				Validators: []validator.Set{
+					setvalidator.ExactlyOneOf(path.MatchRoot("episodes")),
+				},
+			},
+			"episodes": schema.SetNestedAttribute{
+				MarkdownDescription: "Episodes by season and episode number. Exactly one of `episode_ids` and `episodes` must be set, the other one is computed.",
+				Optional:            true,
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Required:            true,
+						},
+						"episode_number": schema.Int64Attribute{
+							MarkdownDescription: "Episode number.",
+							Required:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *EpisodeMonitoringResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *EpisodeMonitoringResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var monitoring *EpisodeMonitoring
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &monitoring)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set episodes monitoring
+	r.apply(ctx, helpers.Create, monitoring, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+episodeMonitoringResourceName+": "+monitoring.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitoring)...)
+}
+
+func (r *EpisodeMonitoringResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var monitoring *EpisodeMonitoring
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &monitoring)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get episodes current value
+	response, httpResp, err := r.client.EpisodeAPI.ListEpisode(helpers.WithAuth(ctx, r.auth)).SeriesId(int32(monitoring.SeriesID.ValueInt64())).Execute()
+	if err != nil {
+		helpers.HandleReadError(ctx, episodeMonitoringResourceName, httpResp, err, resp)
+
+		return
+	}
+
+	// Episodes removed from Sonarr are dropped
+	ids := make([]int32, 0, len(monitoring.EpisodeIDs.Elements()))
+	resp.Diagnostics.Append(monitoring.EpisodeIDs.ElementsAs(ctx, &ids, true)...)
+
+	episodes := slices.DeleteFunc(response, func(e sonarr.EpisodeResource) bool { return !slices.Contains(ids, e.GetId()) })
+
+	tflog.Trace(ctx, "read "+episodeMonitoringResourceName+": "+monitoring.ID.ValueString())
+	// Map response body to resource schema attribute
+	monitoring.write(ctx, episodes, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitoring)...)
+}
+
+func (r *EpisodeMonitoringResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan values
+	var monitoring *EpisodeMonitoring
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &monitoring)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Set episodes monitoring
+	r.apply(ctx, helpers.Update, monitoring, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+episodeMonitoringResourceName+": "+monitoring.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &monitoring)...)
+}
+
+func (r *EpisodeMonitoringResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var ID string
+
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Episode monitoring cannot be really deleted just removing configuration
+	tflog.Trace(ctx, "decoupled "+episodeMonitoringResourceName+": "+ID)
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *EpisodeMonitoringResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	seriesID, episodes, found := strings.Cut(req.ID, ":")
+	ids := make([]int64, 0)
+
+	for _, episode := range strings.Split(episodes, ",") {
+		id, err := strconv.ParseInt(strings.TrimSpace(episode), 10, 64)
+		if err != nil {
+			found = false
+
+			break
+		}
+
+		ids = append(ids, id)
+	}
+
+	series, err := strconv.ParseInt(seriesID, 10, 64)
+	if err != nil || !found {
+		resp.Diagnostics.AddError(
+			helpers.UnexpectedImportIdentifier,
+			fmt.Sprintf("Expected import identifier with format: SERIES_ID:EPISODE_ID,EPISODE_ID,... Got: %s", req.ID),
+		)
+
+		return
+	}
+
+	episodeIDs, diags := types.SetValueFrom(ctx, types.Int64Type, ids)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("series_id"), series)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("episode_ids"), episodeIDs)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("episodes"), types.SetNull(EpisodeNumber{}.getType()))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	tflog.Trace(ctx, "imported "+episodeMonitoringResourceName+": "+req.ID)
+}
+
+// apply sets the monitored flag of the planned episodes with a single request.
+func (r *EpisodeMonitoringResource) apply(ctx context.Context, action string, monitoring *EpisodeMonitoring, diags *diag.Diagnostics) {
+	seriesID := int32(monitoring.SeriesID.ValueInt64())
+
+	response, _, err := r.client.EpisodeAPI.ListEpisode(helpers.WithAuth(ctx, r.auth)).SeriesId(seriesID).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, episodeMonitoringResourceName, err))
+
+		return
+	}
+
+	episodes := monitoring.find(ctx, response, diags)
+	if diags.HasError() {
+		return
+	}
+
+	request := sonarr.NewEpisodesMonitoredResource()
+	request.SetMonitored(monitoring.Monitored.ValueBool())
+	request.SetEpisodeIds(make([]int32, len(episodes)))
+
+	for i, episode := range episodes {
+		request.EpisodeIds[i] = episode.GetId()
+	}
+
+	if _, err := r.client.EpisodeAPI.PutEpisodeMonitor(helpers.WithAuth(ctx, r.auth)).EpisodesMonitoredResource(*request).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, episodeMonitoringResourceName, err))
+
+		return
+	}
+
+	// Read back the episodes
+	response, _, err = r.client.EpisodeAPI.ListEpisode(helpers.WithAuth(ctx, r.auth)).SeriesId(seriesID).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, episodeMonitoringResourceName, err))
+
+		return
+	}
+
+	episodes = slices.DeleteFunc(response, func(e sonarr.EpisodeResource) bool { return !slices.Contains(request.EpisodeIds, e.GetId()) })
+	monitoring.write(ctx, episodes, diags)
+}
+
+// find returns the series episodes matching the configured IDs and numbers.
+func (m *EpisodeMonitoring) find(ctx context.Context, episodes []sonarr.EpisodeResource, diags *diag.Diagnostics) []sonarr.EpisodeResource {
+	ids := make([]int32, 0)
+	numbers := make([]EpisodeNumber, 0)
+
+	if !m.EpisodeIDs.IsUnknown() {
+		diags.Append(m.EpisodeIDs.ElementsAs(ctx, &ids, true)...)
+	}
+
+	if !m.Episodes.IsUnknown() {
+		diags.Append(m.Episodes.ElementsAs(ctx, &numbers, true)...)
+	}
+
+	found := make([]sonarr.EpisodeResource, 0, len(ids)+len(numbers))
+
+	for _, id := range ids {
+		index := slices.IndexFunc(episodes, func(e sonarr.EpisodeResource) bool { return e.GetId() == id })
+		if index < 0 {
+			diags.AddAttributeError(path.Root("episode_ids"), helpers.ClientError,
+				helpers.ParseNotFoundError(episodeMonitoringResourceName, "episode ID", strconv.Itoa(int(id))))
+
+			continue
+		}
+
+		found = append(found, episodes[index])
+	}
+
+	for _, number := range numbers {
+		index := slices.IndexFunc(episodes, func(e sonarr.EpisodeResource) bool {
+			return int64(e.GetSeasonNumber()) == number.SeasonNumber.ValueInt64() && int64(e.GetEpisodeNumber()) == number.EpisodeNumber.ValueInt64()
+		})
+		if index < 0 {
+			diags.AddAttributeError(path.Root("episodes"), helpers.ClientError,
+				helpers.ParseNotFoundError(episodeMonitoringResourceName, "episode",
+					fmt.Sprintf("S%02dE%02d", number.SeasonNumber.ValueInt64(), number.EpisodeNumber.ValueInt64())))
+
+			continue
+		}
+
+		found = append(found, episodes[index])
+	}
+
+	return found
+}
+
+// write stores the given episodes. If any of them has a different monitored flag,
+// the opposite flag is stored, so that the drift is planned to be fixed.
+// The ID includes the episode IDs, since the series episodes can be split among resources.
+func (m *EpisodeMonitoring) write(ctx context.Context, episodes []sonarr.EpisodeResource, diags *diag.Diagnostics) {
+	ids := make([]int64, len(episodes))
+	numbers := make([]EpisodeNumber, len(episodes))
+
+	// imported monitoring takes the flag of the first episode
+	if m.Monitored.IsNull() && len(episodes) > 0 {
+		m.Monitored = types.BoolValue(episodes[0].GetMonitored())
+	}
+
+	monitored := m.Monitored.ValueBool()
+
+	for i, episode := range episodes {
+		ids[i] = int64(episode.GetId())
+		numbers[i].SeasonNumber = types.Int64Value(int64(episode.GetSeasonNumber()))
+		numbers[i].EpisodeNumber = types.Int64Value(int64(episode.GetEpisodeNumber()))
+
+		if episode.GetMonitored() != m.Monitored.ValueBool() {
+			monitored = !m.Monitored.ValueBool()
+		}
+	}
+
+	slices.Sort(ids)
+
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = strconv.FormatInt(id, 10)
+	}
+
+	var tempDiag diag.Diagnostics
+
+	m.ID = types.StringValue(strconv.FormatInt(m.SeriesID.ValueInt64(), 10) + ":" + strings.Join(keys, ","))
+	m.Monitored = types.BoolValue(monitored)
+	m.EpisodeIDs, tempDiag = types.SetValueFrom(ctx, types.Int64Type, ids)
+	diags.Append(tempDiag...)
+	m.Episodes, tempDiag = types.SetValueFrom(ctx, EpisodeNumber{}.getType(), numbers)
+	diags.Append(tempDiag...)
+}
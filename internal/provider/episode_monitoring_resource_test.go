@@ -0,0 +1,167 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strconv"
+	"testing"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+)
+
+func TestAccEpisodeMonitoringResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid episodes testing
+			{
+				Config:      testAccEpisodeMonitoringResourceConfig(9, true),
+				ExpectError: regexp.MustCompile("Unable to find episode_monitoring"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccEpisodeMonitoringResourceConfig(1, true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_episode_monitoring.test", "monitored", "true"),
+					resource.TestCheckResourceAttr("sonarr_episode_monitoring.test", "episode_ids.#", "2"),
+					resource.TestCheckResourceAttrPair("sonarr_episode_monitoring.test", "series_id", "sonarr_series.monitoring", "id"),
+					resource.TestMatchResourceAttr("sonarr_episode_monitoring.test", "id", regexp.MustCompile(`^\d+:\d+,\d+$`)),
+					resource.TestCheckResourceAttr("data.sonarr_episodes.monitored", "episodes.#", "2"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccEpisodeMonitoringResourceConfig(1, true) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update and Read testing
+			{
+				Config: testAccEpisodeMonitoringResourceConfig(1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_episode_monitoring.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("data.sonarr_episodes.monitored", "episodes.#", "0"),
+				),
+			},
+			// Drift testing
+			{
+				Config:             testAccEpisodeMonitoringResourceConfig(1, false),
+				Check:              testAccCheckEpisodesMonitored("sonarr_episode_monitoring.test", true),
+				ExpectNonEmptyPlan: true,
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PostApplyPostRefresh: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sonarr_episode_monitoring.test", plancheck.ResourceActionUpdate),
+					},
+				},
+			},
+			{
+				Config: testAccEpisodeMonitoringResourceConfig(1, false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_episode_monitoring.test", "monitored", "false"),
+					resource.TestCheckResourceAttr("data.sonarr_episodes.monitored", "episodes.#", "0"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_episode_monitoring.test",
+				ImportState:       true,
+				ImportStateIdFunc: testAccEpisodeMonitoringImportID("sonarr_episode_monitoring.test"),
+				ImportStateVerify: true,
+			},
+			// Remove series outside of terraform testing
+			{
+				Config:             testAccEpisodeMonitoringResourceSeriesConfig(1, false),
+				Check:              testAccCheckResourceDisappears("sonarr_series.monitoring", "series"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccEpisodeMonitoringResourceSeriesConfig(1, false),
+				Check:  resource.TestCheckResourceAttr("sonarr_episode_monitoring.test", "monitored", "false"),
+			},
+		},
+	})
+}
+
+func testAccEpisodeMonitoringResourceConfig(season int, monitored bool) string {
+	return testAccEpisodeMonitoringResourceSeriesConfig(season, monitored) + `
+	data "sonarr_episodes" "monitored" {
+		series_id = sonarr_series.monitoring.id
+		monitored = true
+
+		depends_on = [sonarr_episode_monitoring.test]
+	}
+	`
+}
+
+func testAccEpisodeMonitoringResourceSeriesConfig(season int, monitored bool) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "monitoring" {
+		tvdb_id            = 75299
+		root_folder_path   = "/config"
+		quality_profile_id = 1
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+	}
+
+	resource "sonarr_episode_monitoring" "test" {
+		series_id = sonarr_series.monitoring.id
+		monitored = %t
+
+		episodes = [
+			{
+				season_number  = %d
+				episode_number = 1
+			},
+			{
+				season_number  = %d
+				episode_number = 2
+			},
+		]
+	}
+	`, monitored, season, season)
+}
+
+// testAccCheckEpisodesMonitored sets the monitored flag of the episodes directly in Sonarr,
+// so that the following refresh must detect the drift.
+func testAccCheckEpisodesMonitored(name string, monitored bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", name)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.Attributes["episode_ids.0"])
+		if err != nil {
+			return err
+		}
+
+		request := sonarr.NewEpisodesMonitoredResource()
+		request.SetEpisodeIds([]int32{int32(id)})
+		request.SetMonitored(monitored)
+
+		_, err = testAccAPIClient().EpisodeAPI.PutEpisodeMonitor(context.Background()).EpisodesMonitoredResource(*request).Execute()
+
+		return err
+	}
+}
+
+func testAccEpisodeMonitoringImportID(name string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return "", fmt.Errorf("resource not found in state: %s", name)
+		}
+
+		// Episode IDs out of order, as the ID is normalized on read
+		return fmt.Sprintf("%s:%s,%s", rs.Primary.Attributes["series_id"], rs.Primary.Attributes["episode_ids.1"], rs.Primary.Attributes["episode_ids.0"]), nil
+	}
+}
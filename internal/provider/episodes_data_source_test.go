@@ -0,0 +1,79 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEpisodesDataSource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized
+			{
+				Config:      testAccEpisodesDataSourceConfig("series_id = 999999") + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Read testing
+			{
+				Config: testAccEpisodesDataSourceSeriesConfig + testAccEpisodesDataSourceConfig("series_id = sonarr_series.episodes.id"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_episodes.test", "episodes.#", "15"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_episodes.test", "episodes.*", map[string]string{
+						"title":          "Pilot",
+						"season_number":  "1",
+						"episode_number": "1",
+						"has_file":       "true",
+					}),
+				),
+			},
+			// Filter testing
+			{
+				Config: testAccEpisodesDataSourceSeriesConfig + testAccEpisodesDataSourceConfig("series_id = sonarr_series.episodes.id\nseason_number = 2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_episodes.test", "episodes.#", "3"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_episodes.test", "episodes.*", map[string]string{"season_number": "2", "absolute_episode_number": "4"}),
+				),
+			},
+			{
+				Config: testAccEpisodesDataSourceSeriesConfig + testAccEpisodesDataSourceConfig("series_id = sonarr_series.episodes.id\nhas_file = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_episodes.test", "episodes.#", "1"),
+					resource.TestCheckResourceAttr("data.sonarr_episodes.test", "episodes.0.title", "Pilot"),
+				),
+			},
+			{
+				Config: testAccEpisodesDataSourceSeriesConfig + testAccEpisodesDataSourceConfig("series_id = sonarr_series.episodes.id\nmonitored = true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.sonarr_episodes.test", "episodes.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+const testAccEpisodesDataSourceSeriesConfig = `
+resource "sonarr_series" "episodes" {
+	tvdb_id            = 79126
+	root_folder_path   = "/config"
+	quality_profile_id = 1
+
+	monitored           = false
+	season_folder       = true
+	use_scene_numbering = false
+}
+`
+
+func testAccEpisodesDataSourceConfig(filter string) string {
+	return fmt.Sprintf(`
+	data "sonarr_episodes" "test" {
+		%s
+	}
+	`, filter)
+}
@@ -0,0 +1,344 @@
+package provider
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fakeEpisodesPerSeason is the number of episodes in each season of the fake metadata source.
+const fakeEpisodesPerSeason = 3
+
+// fakeCatalogSeries is a series known by the fake metadata source.
+type fakeCatalogSeries struct {
+	title   string
+	network string
+	genres  []string
+	seasons int
+	year    int
+}
+
+// fakeSeriesCatalog contains the series known by the fake lookup.
+// Unknown TVDB IDs are looked up as a single season series.
+var fakeSeriesCatalog = map[int]fakeCatalogSeries{
+	71663:  {"The Simpsons", "FOX", []string{"Animation", "Comedy"}, 35, 1989},
+	72108:  {"NCIS", "CBS", []string{"Action", "Crime", "Drama"}, 21, 2003},
+	73244:  {"The Office", "NBC", []string{"Comedy"}, 9, 2005},
+	75299:  {"The Sopranos", "HBO", []string{"Crime", "Drama"}, 6, 1999},
+	75760:  {"How I Met Your Mother", "CBS", []string{"Comedy", "Romance"}, 9, 2005},
+	76290:  {"24", "FOX", []string{"Action", "Drama", "Thriller"}, 9, 2001},
+	78804:  {"Doctor Who", "BBC One", []string{"Adventure", "Drama", "Science Fiction"}, 13, 2005},
+	78901:  {"Lost", "ABC", []string{"Adventure", "Drama", "Mystery"}, 6, 2004},
+	79126:  {"The Wire", "HBO", []string{"Crime", "Drama", "Thriller"}, 5, 2002},
+	79168:  {"Friends", "NBC", []string{"Comedy", "Romance"}, 10, 1994},
+	80379:  {"The Big Bang Theory", "CBS", []string{"Comedy"}, 12, 2007},
+	81189:  {"Breaking Bad", "AMC", []string{"Crime", "Drama", "Thriller"}, 5, 2008},
+	83462:  {"Castle", "ABC", []string{"Comedy", "Crime", "Drama"}, 8, 2009},
+	121361: {"Game of Thrones", "HBO", []string{"Adventure", "Drama", "Fantasy"}, 8, 2011},
+	153021: {"The Walking Dead", "AMC", []string{"Drama", "Horror", "Thriller"}, 11, 2010},
+	248741: {"2 Broke Girls", "CBS", []string{"Comedy"}, 6, 2011},
+	269613: {"Fargo", "FX", []string{"Crime", "Drama", "Thriller"}, 5, 2014},
+	273181: {"Better Call Saul", "AMC", []string{"Crime", "Drama"}, 6, 2015},
+	305288: {"Stranger Things", "Netflix", []string{"Drama", "Fantasy", "Horror"}, 4, 2016},
+}
+
+// fakeCatalogLookup returns the catalog series with the given TVDB ID.
+func fakeCatalogLookup(tvdbID int) fakeCatalogSeries {
+	series, ok := fakeSeriesCatalog[tvdbID]
+	if !ok {
+		series = fakeCatalogSeries{title: fmt.Sprintf("Series %d", tvdbID), genres: []string{}, seasons: 1}
+	}
+
+	return series
+}
+
+// fakeMetadata returns the read-only series attributes filled by Sonarr from the metadata source.
+func (c fakeCatalogSeries) fakeMetadata(tvdbID int) fakeObject {
+	return fakeObject{
+		"status":           "ended",
+		"network":          c.network,
+		"year":             c.year,
+		"genres":           c.genres,
+		"runtime":          45,
+		"originalLanguage": fakeObject{"id": 1, "name": "English"},
+		"alternateTitles":  []interface{}{},
+		"images": []interface{}{
+			fakeObject{
+				"coverType": "poster",
+				"url":       fmt.Sprintf("/MediaCover/%d/poster.jpg", tvdbID),
+				"remoteUrl": fmt.Sprintf("https://artworks.thetvdb.com/banners/posters/%d-1.jpg", tvdbID),
+			},
+		},
+		"statistics": fakeObject{"seasonCount": c.seasons, "episodeCount": 0, "episodeFileCount": 0, "totalEpisodeCount": 0, "sizeOnDisk": 0},
+	}
+}
+
+// fakeFolder builds the series folder like the Sonarr lookup does, for the title tokens only.
+func (c fakeCatalogSeries) fakeFolder(format string) string {
+	return strings.NewReplacer(
+		"{Series Title}", c.title,
+		"{Series TitleYear}", fmt.Sprintf("%s (%d)", c.title, c.year),
+	).Replace(format)
+}
+
+// fakeSeasons builds the seasons of the series, specials included, keeping the monitoring of the given ones.
+// Like Sonarr refresh, new seasons are monitored with the series, except specials.
+func (c fakeCatalogSeries) fakeSeasons(monitored bool, existing interface{}) []interface{} {
+	known := make(map[string]interface{})
+
+	current, _ := existing.([]interface{})
+	for _, season := range current {
+		if season, ok := season.(fakeObject); ok {
+			known[fmt.Sprint(season["seasonNumber"])] = season["monitored"]
+		}
+	}
+
+	seasons := make([]interface{}, 0, c.seasons+1)
+
+	for number := 0; number <= c.seasons; number++ {
+		seasonMonitored, ok := known[strconv.Itoa(number)]
+		if !ok {
+			seasonMonitored = monitored && number > 0
+		}
+
+		seasons = append(seasons, fakeObject{"seasonNumber": number, "monitored": seasonMonitored})
+	}
+
+	return seasons
+}
+
+// fakeEpisodes builds the regular episodes of the series, one week apart starting from its year.
+// Like Sonarr refresh, episodes are monitored with their season.
+func (c fakeCatalogSeries) fakeEpisodes(series fakeObject) []fakeObject {
+	tvdbID, _ := strconv.Atoi(fmt.Sprint(series["tvdbId"]))
+	monitored := make(map[string]bool)
+
+	seasons, _ := series["seasons"].([]interface{})
+	for _, season := range seasons {
+		if season, ok := season.(fakeObject); ok {
+			monitored[fmt.Sprint(season["seasonNumber"])], _ = season["monitored"].(bool)
+		}
+	}
+
+	start := time.Date(max(c.year, 1990), time.September, 1, 1, 0, 0, 0, time.UTC)
+	episodes := make([]fakeObject, 0, c.seasons*fakeEpisodesPerSeason)
+
+	for season := 1; season <= c.seasons; season++ {
+		for number := 1; number <= fakeEpisodesPerSeason; number++ {
+			absolute := (season-1)*fakeEpisodesPerSeason + number
+			airDate := start.AddDate(season-1, 0, 7*(number-1))
+			title := fmt.Sprintf("Episode %d", number)
+
+			if absolute == 1 {
+				title = "Pilot"
+			}
+
+			episodes = append(episodes, fakeObject{
+				"seriesId":              series["id"],
+				"tvdbId":                tvdbID*100 + absolute,
+				"seasonNumber":          season,
+				"episodeNumber":         number,
+				"absoluteEpisodeNumber": absolute,
+				"title":                 title,
+				"overview":              fmt.Sprintf("%s episode %d of season %d.", c.title, number, season),
+				"airDate":               airDate.Format(time.DateOnly),
+				"airDateUtc":            airDate.Format(time.RFC3339),
+				"runtime":               45,
+				"monitored":             monitored[strconv.Itoa(season)],
+				"hasFile":               false,
+				"episodeFileId":         0,
+			})
+		}
+	}
+
+	return episodes
+}
+
+// fakeProviderFamilies maps each provider collection to its fields and implementation models.
+func fakeProviderFamilies() map[string]fakeProviderFamily {
+	return map[string]fakeProviderFamily{
+		"downloadclient": {
+			fields: downloadClientFields,
+			implementations: map[string]interface{}{
+				downloadClientAria2Implementation:                  DownloadClientAria2{},
+				downloadClientDelugeImplementation:                 DownloadClientDeluge{},
+				downloadClientFloodImplementation:                  DownloadClientFlood{},
+				downloadClientHadoukenImplementation:               DownloadClientHadouken{},
+				downloadClientNzbgetImplementation:                 DownloadClientNzbget{},
+				downloadClientNzbvortexImplementation:              DownloadClientNzbvortex{},
+				downloadClientPneumaticImplementation:              DownloadClientPneumatic{},
+				downloadClientQbittorrentImplementation:            DownloadClientQbittorrent{},
+				downloadClientRtorrentImplementation:               DownloadClientRtorrent{},
+				downloadClientSabnzbdImplementation:                DownloadClientSabnzbd{},
+				downloadClientTorrentBlackholeImplementation:       DownloadClientTorrentBlackhole{},
+				downloadClientTorrentDownloadStationImplementation: DownloadClientTorrentDownloadStation{},
+				downloadClientTransmissionImplementation:           DownloadClientTransmission{},
+				downloadClientUsenetBlackholeImplementation:        DownloadClientUsenetBlackhole{},
+				downloadClientUsenetDownloadStationImplementation:  DownloadClientUsenetDownloadStation{},
+				downloadClientUtorrentImplementation:               DownloadClientUtorrent{},
+				downloadClientVuzeImplementation:                   DownloadClientVuze{},
+			},
+		},
+		"importlist": {
+			fields: importListFields,
+			implementations: map[string]interface{}{
+				importListCustomImplementation:       ImportListCustom{},
+				importListImdbImplementation:         ImportListImdb{},
+				importListPlexImplementation:         ImportListPlex{},
+				importListPlexRSSImplementation:      ImportListPlexRSS{},
+				importListSimklUserImplementation:    ImportListSimklUser{},
+				importListSonarrImplementation:       ImportListSonarr{},
+				importListTraktListImplementation:    ImportListTraktList{},
+				importListTraktPopularImplementation: ImportListTraktPopular{},
+				importListTraktUserImplementation:    ImportListTraktUser{},
+			},
+		},
+		"indexer": {
+			fields: indexerFields,
+			implementations: map[string]interface{}{
+				indexerBroadcastheNetImplementation: IndexerBroadcastheNet{},
+				indexerFanzubImplementation:         IndexerFanzub{},
+				indexerFilelistImplementation:       IndexerFilelist{},
+				indexerHdbitsImplementation:         IndexerHdbits{},
+				indexerIptorrentsImplementation:     IndexerIptorrents{},
+				indexerNewznabImplementation:        IndexerNewznab{},
+				indexerNyaaImplementation:           IndexerNyaa{},
+				indexerTorrentRssImplementation:     IndexerTorrentRss{},
+				indexerTorrentleechImplementation:   IndexerTorrentleech{},
+				indexerTorznabImplementation:        IndexerTorznab{},
+			},
+		},
+		"metadata": {
+			fields: metadataFields,
+			implementations: map[string]interface{}{
+				metadataKodiImplementation:    MetadataKodi{},
+				metadataRoksboxImplementation: MetadataRoksbox{},
+				metadataWdtvImplementation:    MetadataWdtv{},
+			},
+		},
+		"notification": {
+			fields: notificationFields,
+			implementations: map[string]interface{}{
+				notificationAppriseImplementation:      NotificationApprise{},
+				notificationCustomScriptImplementation: NotificationCustomScript{},
+				notificationDiscordImplementation:      NotificationDiscord{},
+				notificationEmailImplementation:        NotificationEmail{},
+				notificationEmbyImplementation:         NotificationEmby{},
+				notificationGotifyImplementation:       NotificationGotify{},
+				notificationJoinImplementation:         NotificationJoin{},
+				notificationKodiImplementation:         NotificationKodi{},
+				notificationMailgunImplementation:      NotificationMailgun{},
+				notificationNtfyImplementation:         NotificationNtfy{},
+				notificationPlexImplementation:         NotificationPlex{},
+				notificationProwlImplementation:        NotificationProwl{},
+				notificationPushbulletImplementation:   NotificationPushbullet{},
+				notificationPushoverImplementation:     NotificationPushover{},
+				notificationSendgridImplementation:     NotificationSendgrid{},
+				notificationSignalImplementation:       NotificationSignal{},
+				notificationSimplepushImplementation:   NotificationSimplepush{},
+				notificationSlackImplementation:        NotificationSlack{},
+				notificationSynologyImplementation:     NotificationSynology{},
+				notificationTelegramImplementation:     NotificationTelegram{},
+				notificationTraktImplementation:        NotificationTrakt{},
+				notificationTwitterImplementation:      NotificationTwitter{},
+				notificationWebhookImplementation:      NotificationWebhook{},
+			},
+		},
+	}
+}
+
+// seed populates the fake server with the data available on a fresh Sonarr installation.
+func (f *fakeSonarr) seed() {
+	f.status = fakeObject{
+		"appName":        "Sonarr",
+		"instanceName":   "Sonarr",
+		"version":        fakeSonarrVersion,
+		"isProduction":   true,
+		"isDebug":        false,
+		"isDocker":       true,
+		"osName":         "ubuntu",
+		"runtimeName":    "netCore",
+		"urlBase":        f.urlBase,
+		"databaseType":   "sqLite",
+		"branch":         "main",
+		"authentication": "forms",
+		"mode":           "console",
+	}
+
+	f.configs["host"] = fakeObject{
+		"id": 1, "bindAddress": "*", "port": 8989, "sslPort": 9898, "enableSsl": false, "launchBrowser": true,
+		"authenticationMethod": "none", "authenticationRequired": "enabled", "analyticsEnabled": false,
+		"username": "", "password": "", "logLevel": "info", "consoleLogLevel": "", "branch": "main",
+		"apiKey": f.apiKey, "sslCertPath": "", "sslCertPassword": "", "urlBase": f.urlBase, "instanceName": "Sonarr",
+		"updateAutomatically": false, "updateMechanism": "docker", "updateScriptPath": "",
+		"proxyEnabled": false, "proxyType": "http", "proxyHostname": "", "proxyPort": 8080, "proxyUsername": "",
+		"proxyPassword": "", "proxyBypassFilter": "", "proxyBypassLocalAddresses": true,
+		"certificateValidation": "enabled", "backupFolder": "Backups", "backupInterval": 7, "backupRetention": 28,
+	}
+	f.configs["naming"] = fakeObject{
+		"id": 1, "renameEpisodes": false, "replaceIllegalCharacters": true, "colonReplacementFormat": 4, "multiEpisodeStyle": 0,
+		"standardEpisodeFormat": "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}",
+		"dailyEpisodeFormat":    "{Series Title} - {Air-Date} - {Episode Title} {Quality Full}",
+		"animeEpisodeFormat":    "{Series Title} - S{season:00}E{episode:00} - {Episode Title} {Quality Full}",
+		"seriesFolderFormat":    "{Series Title}",
+		"seasonFolderFormat":    "Season {season}",
+		"specialsFolderFormat":  "Specials",
+	}
+	f.configs["mediamanagement"] = fakeObject{
+		"id": 1, "autoUnmonitorPreviouslyDownloadedEpisodes": false, "recycleBin": "", "recycleBinCleanupDays": 7,
+		"downloadPropersAndRepacks": "preferAndUpgrade", "createEmptySeriesFolders": false, "deleteEmptyFolders": false,
+		"fileDate": "none", "rescanAfterRefresh": "always", "setPermissionsLinux": false, "chmodFolder": "755",
+		"chownGroup": "", "episodeTitleRequired": "always", "skipFreeSpaceCheckWhenImporting": false,
+		"minimumFreeSpaceWhenImporting": 100, "copyUsingHardlinks": true, "useScriptImport": false, "scriptImportPath": "",
+		"importExtraFiles": false, "extraFileExtensions": "srt", "enableMediaInfo": true,
+	}
+	f.configs["indexer"] = fakeObject{"id": 1, "minimumAge": 0, "retention": 0, "maximumSize": 0, "rssSyncInterval": 15}
+	f.configs["downloadclient"] = fakeObject{
+		"id": 1, "downloadClientWorkingFolders": "_UNPACK_|_FAILED_", "enableCompletedDownloadHandling": true,
+		"autoRedownloadFailed": true,
+	}
+	f.configs["ui"] = fakeObject{"id": 1, "firstDayOfWeek": 0, "theme": "auto", "uiLanguage": 1}
+
+	f.seedQualities()
+	f.seedLanguages()
+
+	f.insert("delayprofile", fakeObject{
+		"enableUsenet": true, "enableTorrent": true, "preferredProtocol": "usenet", "usenetDelay": 0, "torrentDelay": 0,
+		"bypassIfHighestQuality": true, "bypassIfAboveCustomFormatScore": false, "minimumCustomFormatScore": 0,
+		"order": 2147483647, "tags": []interface{}{},
+	})
+}
+
+// seedQualities adds the default quality definitions and quality profiles.
+func (f *fakeSonarr) seedQualities() {
+	definitions := defaultQualityDefinitions()
+	items := make([]interface{}, 0, len(definitions))
+
+	for _, definition := range definitions {
+		q := definition.GetQuality()
+		quality := fakeObject{"id": q.GetId(), "name": q.GetName(), "source": q.GetSource(), "resolution": q.GetResolution()}
+		f.insert("qualitydefinition", fakeObject{
+			"quality": quality, "title": definition.GetTitle(), "weight": definition.GetWeight(),
+			"minSize": 0.0, "maxSize": 1000.0, "preferredSize": 995.0,
+		})
+
+		items = append(items, fakeObject{"quality": quality, "items": []interface{}{}, "allowed": true})
+	}
+	for _, name := range []string{"Any", "SD", "HD-720p", "HD-1080p", "Ultra-HD", "HD - 720p/1080p"} {
+		f.insert("qualityprofile", fakeObject{
+			"name": name, "upgradeAllowed": false, "cutoff": 1, "items": items,
+			"minFormatScore": 0, "cutoffFormatScore": 0, "formatItems": []interface{}{},
+		})
+	}
+}
+
+// seedLanguages adds the languages supported by Sonarr.
+func (f *fakeSonarr) seedLanguages() {
+	f.collections["language"] = make(map[int]fakeObject, len(defaultLanguages))
+	for _, language := range defaultLanguageResources() {
+		f.collections["language"][int(language.GetId())] = fakeObject{
+			"id": language.GetId(), "name": language.GetName(), "nameLower": language.GetNameLower(),
+		}
+	}
+}
@@ -0,0 +1,935 @@
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"maps"
+	"net/http"
+	"net/http/httptest"
+	"path"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+)
+
+const (
+	fakeSonarrAPIKey  = "fake-sonarr-api-key"
+	fakeSonarrAPIPath = "/api/v3/"
+	fakeSonarrVersion = "4.0.2.1183"
+)
+
+// fakeObject is a generic JSON object as stored by the fake server.
+type fakeObject = map[string]interface{}
+
+// fakeReadOnlyKeys lists the values Sonarr ignores on update.
+var fakeReadOnlyKeys = map[string][]string{
+	"qualitydefinition": {"quality"},
+}
+
+// fakeProviderFamily describes a Sonarr provider collection (download clients, indexers...)
+// whose fields depend on the implementation.
+type fakeProviderFamily struct {
+	fields          helpers.Fields
+	implementations map[string]interface{}
+}
+
+// fakeFailure describes a transient error the fake server returns to the next matching requests.
+type fakeFailure struct {
+	method   string
+	endpoint string
+	status   int
+	count    int
+}
+
+// fakeSonarr is an in-memory stand-in of the Sonarr API used by acceptance tests.
+type fakeSonarr struct {
+	*httptest.Server
+	collections map[string]map[int]fakeObject
+	nextID      map[string]int
+	configs     map[string]fakeObject
+	status      fakeObject
+	failures    []*fakeFailure
+	apiKey      string
+	urlBase     string
+	mu          sync.Mutex
+	// writeDelay makes writes last longer and fail with "database is locked" when concurrent, like SQLite.
+	writeDelay time.Duration
+	writes     atomic.Int32
+}
+
+// newFakeSonarr starts a fake Sonarr server seeded with the default Sonarr data.
+// All API calls must be authenticated with the given API key and prefixed with urlBase.
+func newFakeSonarr(apiKey, urlBase string) *fakeSonarr {
+	f := initFakeSonarr(apiKey, urlBase)
+	f.Server = httptest.NewServer(http.HandlerFunc(f.serveHTTP))
+
+	return f
+}
+
+// newFakeSonarrTLS starts a fake Sonarr server over HTTPS with a self-signed certificate.
+// If clientCAs is set, clients must authenticate with a certificate signed by one of them.
+func newFakeSonarrTLS(apiKey, urlBase string, clientCAs *x509.CertPool) *fakeSonarr {
+	f := initFakeSonarr(apiKey, urlBase)
+	f.Server = httptest.NewUnstartedServer(http.HandlerFunc(f.serveHTTP))
+
+	if clientCAs != nil {
+		f.Server.TLS = &tls.Config{
+			MinVersion: tls.VersionTLS12,
+			ClientAuth: tls.RequireAndVerifyClientCert,
+			ClientCAs:  clientCAs,
+		}
+	}
+
+	f.StartTLS()
+
+	return f
+}
+
+// initFakeSonarr creates the fake Sonarr state.
+func initFakeSonarr(apiKey, urlBase string) *fakeSonarr {
+	f := &fakeSonarr{
+		apiKey:      apiKey,
+		urlBase:     strings.TrimSuffix(urlBase, "/"),
+		collections: make(map[string]map[int]fakeObject),
+		nextID:      make(map[string]int),
+		configs:     make(map[string]fakeObject),
+	}
+
+	f.seed()
+
+	return f
+}
+
+// certificatePEM returns the server certificate in PEM format.
+func (f *fakeSonarr) certificatePEM() string {
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: f.Certificate().Raw}))
+}
+
+// URL returns the base URL of the fake server, including the URL base.
+func (f *fakeSonarr) URL() string {
+	return f.Server.URL + f.urlBase
+}
+
+// failNext makes the next count requests with the given method to the API endpoint (e.g. tag) fail with status.
+func (f *fakeSonarr) failNext(method, endpoint string, status, count int) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.failures = append(f.failures, &fakeFailure{method: method, endpoint: endpoint, status: status, count: count})
+}
+
+// injectedFailure consumes the first pending failure matching the request, if any.
+func (f *fakeSonarr) injectedFailure(method, endpoint string) int {
+	for _, failure := range f.failures {
+		if failure.method == method && strings.HasPrefix(endpoint, failure.endpoint) && failure.count > 0 {
+			failure.count--
+
+			return failure.status
+		}
+	}
+
+	return 0
+}
+
+// insert stores the object in the given collection assigning a new ID.
+func (f *fakeSonarr) insert(collection string, object fakeObject) fakeObject {
+	if f.collections[collection] == nil {
+		f.collections[collection] = make(map[int]fakeObject)
+	}
+
+	f.nextID[collection]++
+	id := f.nextID[collection]
+	object["id"] = id
+	f.collections[collection][id] = object
+
+	return object
+}
+
+// list returns the objects of a collection ordered by ID.
+func (f *fakeSonarr) list(collection string) []fakeObject {
+	ids := make([]int, 0, len(f.collections[collection]))
+	for id := range f.collections[collection] {
+		ids = append(ids, id)
+	}
+
+	slices.Sort(ids)
+
+	output := make([]fakeObject, len(ids))
+	for i, id := range ids {
+		output[i] = f.collections[collection][id]
+	}
+
+	return output
+}
+
+func (f *fakeSonarr) serveHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.URL.Path, f.urlBase+fakeSonarrAPIPath) {
+		writeFakeError(w, http.StatusNotFound, "Not Found")
+
+		return
+	}
+
+	if r.Header.Get("X-Api-Key") != f.apiKey && r.URL.Query().Get("apikey") != f.apiKey {
+		writeFakeError(w, http.StatusUnauthorized, "Unauthorized")
+
+		return
+	}
+
+	var body interface{}
+	if r.Body != nil && r.ContentLength != 0 {
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+			writeFakeError(w, http.StatusBadRequest, err.Error())
+
+			return
+		}
+	}
+
+	if f.writeDelay > 0 && r.Method != http.MethodGet {
+		defer f.writes.Add(-1)
+
+		if f.writes.Add(1) > 1 {
+			writeFakeError(w, http.StatusInternalServerError, "database is locked")
+
+			return
+		}
+
+		time.Sleep(f.writeDelay)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	endpoint := strings.Trim(strings.TrimPrefix(r.URL.Path, f.urlBase+fakeSonarrAPIPath), "/")
+	if status := f.injectedFailure(r.Method, endpoint); status != 0 {
+		writeFakeError(w, status, http.StatusText(status))
+
+		return
+	}
+
+	segments := strings.Split(endpoint, "/")
+	status, response := f.route(r, segments, body)
+
+	if failures, ok := response.([]fakeObject); ok && status >= http.StatusBadRequest {
+		writeFakeJSON(w, status, failures)
+
+		return
+	}
+
+	if status >= http.StatusBadRequest {
+		writeFakeError(w, status, fmt.Sprint(response))
+
+		return
+	}
+
+	writeFakeJSON(w, status, response)
+}
+
+// route dispatches the request to the right handler depending on path segments.
+func (f *fakeSonarr) route(r *http.Request, segments []string, body interface{}) (int, interface{}) {
+	switch {
+	case segments[0] == "system" && len(segments) == 2 && segments[1] == "status":
+		return http.StatusOK, f.status
+	case segments[0] == "config" && len(segments) > 1:
+		return f.serveConfig(r, segments[1], body)
+	case segments[0] == "series" && len(segments) == 2 && segments[1] == "lookup":
+		return f.serveSeriesLookup(r.URL.Query().Get("term"))
+	case segments[0] == "series" && len(segments) == 2 && segments[1] == "import":
+		return f.serveSeriesImport(r, body)
+	case segments[0] == "series" && len(segments) == 2 && segments[1] == "editor":
+		return f.serveSeriesEditor(r, body)
+	case segments[0] == "episode" && len(segments) == 2 && segments[1] == "monitor":
+		return f.serveEpisodeMonitor(r, body)
+	case segments[0] == "episodefile" && len(segments) == 2 && segments[1] == "editor":
+		return f.serveEpisodeFileEditor(r, body)
+	case segments[0] == "calendar" && len(segments) == 1:
+		return f.serveCalendar(r)
+	case len(segments) == 2 && segments[1] == "schema":
+		return f.serveSchema(segments[0])
+	case len(segments) == 2 && (segments[1] == "test" || segments[1] == "testall"):
+		return http.StatusOK, fakeObject{}
+	case len(segments) == 1:
+		return f.serveCollection(r, segments[0], body)
+	case len(segments) == 2:
+		id, err := strconv.Atoi(segments[1])
+		if err != nil {
+			return http.StatusNotFound, "Not Found"
+		}
+
+		return f.serveItem(r, segments[0], id, body)
+	}
+
+	return http.StatusNotFound, "Not Found"
+}
+
+func (f *fakeSonarr) serveConfig(r *http.Request, name string, body interface{}) (int, interface{}) {
+	config, ok := f.configs[name]
+	if !ok {
+		return http.StatusNotFound, "Not Found"
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return http.StatusOK, config
+	case http.MethodPut:
+		update, _ := body.(fakeObject)
+		for k, v := range update {
+			config[k] = v
+		}
+
+		config["id"] = 1
+
+		return http.StatusAccepted, config
+	}
+
+	return http.StatusMethodNotAllowed, "Method Not Allowed"
+}
+
+func (f *fakeSonarr) serveSchema(collection string) (int, interface{}) {
+	family, ok := fakeProviderFamilies()[collection]
+	if !ok {
+		return http.StatusNotFound, "Not Found"
+	}
+
+	schema := make([]fakeObject, 0, len(family.implementations))
+	for implementation := range family.implementations {
+		object := fakeObject{"implementation": implementation, "configContract": implementation + "Settings"}
+		family.fillFields(object)
+		schema = append(schema, object)
+	}
+
+	return http.StatusOK, schema
+}
+
+func (f *fakeSonarr) serveCollection(r *http.Request, collection string, body interface{}) (int, interface{}) {
+	switch r.Method {
+	case http.MethodGet:
+		items := f.list(collection)
+		if collection == "command" {
+			return http.StatusOK, f.runCommands(items)
+		}
+
+		if tvdbID := r.URL.Query().Get("tvdbId"); collection == "series" && tvdbID != "" {
+			items = slices.DeleteFunc(items, func(s fakeObject) bool { return fmt.Sprint(s["tvdbId"]) != tvdbID })
+		}
+
+		// Like Sonarr, episodes of a missing series are not found
+		if seriesID, err := strconv.Atoi(r.URL.Query().Get("seriesId")); collection == "episode" && err == nil && f.collections["series"][seriesID] == nil {
+			return http.StatusNotFound, "Not Found"
+		}
+
+		for _, filter := range []string{"seriesId", "seasonNumber", "episodeFileId"} {
+			if value := r.URL.Query().Get(filter); (collection == "episode" || collection == "episodefile") && value != "" {
+				items = slices.DeleteFunc(items, func(e fakeObject) bool { return fmt.Sprint(e[filter]) != value })
+			}
+		}
+
+		return http.StatusOK, items
+	case http.MethodPost:
+		object, ok := body.(fakeObject)
+		if !ok {
+			return http.StatusBadRequest, "Invalid request body"
+		}
+
+		if failures := f.validate(collection, 0, object); failures != nil {
+			return http.StatusBadRequest, failures
+		}
+
+		f.prepare(collection, object)
+
+		if collection == "series" {
+			return http.StatusCreated, f.addSeries(object)
+		}
+
+		return http.StatusCreated, f.insert(collection, object)
+	}
+
+	return http.StatusMethodNotAllowed, "Method Not Allowed"
+}
+
+func (f *fakeSonarr) serveItem(r *http.Request, collection string, id int, body interface{}) (int, interface{}) {
+	object, ok := f.collections[collection][id]
+	if !ok {
+		return http.StatusNotFound, "Not Found"
+	}
+
+	switch r.Method {
+	case http.MethodGet:
+		return http.StatusOK, object
+	case http.MethodPut:
+		update, ok := body.(fakeObject)
+		if !ok {
+			return http.StatusBadRequest, "Invalid request body"
+		}
+
+		if failures := f.validate(collection, id, update); failures != nil {
+			return http.StatusBadRequest, failures
+		}
+
+		for _, key := range fakeReadOnlyKeys[collection] {
+			update[key] = object[key]
+		}
+
+		if collection == "series" && r.URL.Query().Get("moveFiles") == "true" && update["path"] != object["path"] {
+			f.insert("command", fakeObject{
+				"name":        "MoveSeries",
+				"commandName": "Move Series",
+				"status":      "started",
+				"body":        fakeObject{"seriesId": id, "sourcePath": object["path"], "destinationPath": update["path"]},
+			})
+		}
+
+		f.prepare(collection, update)
+		update["id"] = id
+		f.collections[collection][id] = update
+
+		return http.StatusAccepted, update
+	case http.MethodDelete:
+		if collection == "series" {
+			f.deleteSeries(id, r.URL.Query().Get("addImportListExclusion") == "true")
+
+			return http.StatusOK, nil
+		}
+
+		delete(f.collections[collection], id)
+
+		return http.StatusOK, nil
+	}
+
+	return http.StatusMethodNotAllowed, "Method Not Allowed"
+}
+
+// addSeries inserts the series, queuing its refresh like Sonarr does.
+// The refresh adds the series episodes, with a file for the pilot.
+func (f *fakeSonarr) addSeries(series fakeObject) fakeObject {
+	series = f.insert("series", series)
+
+	f.insert("command", fakeObject{
+		"name":        "RefreshSeries",
+		"commandName": "Refresh Series",
+		"status":      "started",
+		"body":        fakeObject{"seriesIds": []interface{}{series["id"]}, "isNewSeries": true},
+	})
+
+	tvdbID, _ := strconv.Atoi(fmt.Sprint(series["tvdbId"]))
+	for _, episode := range fakeCatalogLookup(tvdbID).fakeEpisodes(series) {
+		if episode["absoluteEpisodeNumber"] == 1 {
+			relativePath := fmt.Sprintf("Season 1/%s - S01E01 - %s.mkv", series["title"], episode["title"])
+			file := f.insert("episodefile", fakeObject{
+				"seriesId":     series["id"],
+				"seasonNumber": 1,
+				"relativePath": relativePath,
+				"path":         fmt.Sprintf("%s/%s", series["path"], relativePath),
+				"size":         1 << 30,
+				"dateAdded":    episode["airDateUtc"],
+				"releaseGroup": "GROUP",
+				"languages":    []interface{}{fakeObject{"id": 1, "name": "English"}},
+				"quality": fakeObject{
+					"quality":  fakeObject{"id": 4, "name": "HDTV-720p", "source": "television", "resolution": 720},
+					"revision": fakeObject{"version": 1, "real": 0, "isRepack": false},
+				},
+				"mediaInfo": fakeObject{
+					"audioBitrate":     128000,
+					"audioChannels":    2.0,
+					"audioCodec":       "AAC",
+					"audioLanguages":   "eng",
+					"audioStreamCount": 1,
+					"videoBitDepth":    8,
+					"videoBitrate":     2500000,
+					"videoCodec":       "x264",
+					"videoFps":         23.976,
+					"resolution":       "1280x720",
+					"runTime":          "44:10",
+					"scanType":         "Progressive",
+					"subtitles":        "eng",
+				},
+			})
+			episode["hasFile"] = true
+			episode["episodeFileId"] = file["id"]
+		}
+
+		f.insert("episode", episode)
+	}
+
+	return series
+}
+
+// deleteSeries deletes the series with its episodes and files, optionally excluding it from import lists.
+func (f *fakeSonarr) deleteSeries(id int, addImportListExclusion bool) {
+	series := f.collections["series"][id]
+	delete(f.collections["series"], id)
+
+	for _, collection := range []string{"episode", "episodefile"} {
+		maps.DeleteFunc(f.collections[collection], func(_ int, object fakeObject) bool { return object["seriesId"] == id })
+	}
+
+	if addImportListExclusion {
+		f.insert("importlistexclusion", fakeObject{"tvdbId": series["tvdbId"], "title": series["title"]})
+	}
+}
+
+// runCommands returns the commands as they are, then completes the started ones,
+// so that clients waiting for a command need to poll it at least twice.
+func (f *fakeSonarr) runCommands(commands []fakeObject) []fakeObject {
+	output := make([]fakeObject, len(commands))
+	for i, command := range commands {
+		output[i] = maps.Clone(command)
+
+		if command["status"] == "started" {
+			command["status"] = "completed"
+			f.completeCommand(command)
+		}
+	}
+
+	return output
+}
+
+// completeCommand applies the command effects, for a new series refresh it applies its monitor add option.
+func (f *fakeSonarr) completeCommand(command fakeObject) {
+	body, _ := command["body"].(fakeObject)
+	if command["name"] != "RefreshSeries" || body["isNewSeries"] != true {
+		return
+	}
+
+	ids, _ := body["seriesIds"].([]interface{})
+	for _, id := range ids {
+		id, _ := strconv.Atoi(fmt.Sprint(id))
+
+		series, ok := f.collections["series"][id]
+		if !ok {
+			continue
+		}
+
+		options, _ := series["addOptions"].(fakeObject)
+		seasons, _ := series["seasons"].([]interface{})
+
+		for i, season := range seasons {
+			season, _ := season.(fakeObject)
+			number, _ := strconv.Atoi(fmt.Sprint(season["seasonNumber"]))
+
+			switch options["monitor"] {
+			case "all":
+				season["monitored"] = number > 0
+			case "none":
+				season["monitored"] = false
+			case "firstSeason":
+				season["monitored"] = number == 1
+			case "latestSeason", "lastSeason":
+				season["monitored"] = i == len(seasons)-1
+			}
+		}
+	}
+}
+
+// validate reproduces the Sonarr uniqueness checks the provider relies on.
+func (f *fakeSonarr) validate(collection string, id int, object fakeObject) []fakeObject {
+	if _, ok := fakeProviderFamilies()[collection]; ok {
+		return validateFakeFields(object)
+	}
+
+	unique := map[string]string{
+		"series":     "tvdbId",
+		"rootfolder": "path",
+		"tag":        "label",
+	}
+
+	key, ok := unique[collection]
+	if !ok {
+		return nil
+	}
+
+	for _, existing := range f.collections[collection] {
+		if existing["id"] != id && fmt.Sprint(existing[key]) == fmt.Sprint(object[key]) {
+			property := strings.ToUpper(key[:1]) + key[1:]
+			message := fmt.Sprintf("'%s' must be unique", property)
+
+			if collection == "series" {
+				message = "This series has already been added"
+			}
+
+			return []fakeObject{fakeValidationFailure(property, message)}
+		}
+	}
+
+	return nil
+}
+
+// validateFakeFields checks the provider fields as Sonarr settings validators do.
+func validateFakeFields(object fakeObject) []fakeObject {
+	fields, _ := object["fields"].([]interface{})
+	for _, field := range fields {
+		field, _ := field.(fakeObject)
+		if field["name"] == "host" && strings.Contains(fmt.Sprint(field["value"]), " ") {
+			return []fakeObject{fakeValidationFailure("Host", "'Host' must be valid Host without http://")}
+		}
+	}
+
+	return nil
+}
+
+// fakeValidationFailure builds a validation failure like the ones Sonarr returns on rejected payloads.
+func fakeValidationFailure(property, message string) fakeObject {
+	return fakeObject{
+		"propertyName": property,
+		"errorMessage": message,
+		"severity":     "error",
+		"isWarning":    false,
+	}
+}
+
+// prepare fills the values Sonarr computes on its side.
+func (f *fakeSonarr) prepare(collection string, object fakeObject) {
+	if family, ok := fakeProviderFamilies()[collection]; ok {
+		family.fillFields(object)
+	}
+
+	switch collection {
+	case "rootfolder":
+		object["accessible"] = true
+		object["freeSpace"] = 1 << 30
+		object["unmappedFolders"] = []interface{}{}
+	case "series":
+		if _, ok := object["path"]; !ok {
+			object["path"] = fmt.Sprintf("%s/%s", object["rootFolderPath"], object["title"])
+		}
+
+		object["rootFolderPath"] = path.Dir(fmt.Sprint(object["path"]))
+
+		if _, ok := object["seriesType"]; !ok {
+			object["seriesType"] = "standard"
+		}
+
+		if _, ok := object["monitorNewItems"]; !ok {
+			object["monitorNewItems"] = "all"
+		}
+
+		tvdbID, _ := strconv.Atoi(fmt.Sprint(object["tvdbId"]))
+		monitored, _ := object["monitored"].(bool)
+		series := fakeCatalogLookup(tvdbID)
+		object["seasons"] = series.fakeSeasons(monitored, object["seasons"])
+		maps.Copy(object, series.fakeMetadata(tvdbID))
+	}
+}
+
+func (f *fakeSonarr) serveSeriesLookup(term string) (int, interface{}) {
+	tvdbID, err := strconv.Atoi(strings.TrimPrefix(term, "tvdb:"))
+	if err != nil || tvdbID <= 0 {
+		return http.StatusOK, []fakeObject{}
+	}
+
+	series := fakeCatalogLookup(tvdbID)
+	slug := strings.ReplaceAll(strings.ToLower(series.title), " ", "-")
+
+	lookup := fakeObject{
+		"title":             series.title,
+		"titleSlug":         slug,
+		"tvdbId":            tvdbID,
+		"monitored":         false,
+		"seasonFolder":      true,
+		"useSceneNumbering": false,
+		"seriesType":        "standard",
+		"monitorNewItems":   "all",
+		"qualityProfileId":  0,
+		"path":              "",
+		"rootFolderPath":    "",
+		"folder":            series.fakeFolder(fmt.Sprint(f.configs["naming"]["seriesFolderFormat"])),
+		"tags":              []interface{}{},
+		"seasons":           series.fakeSeasons(false, nil),
+	}
+	maps.Copy(lookup, series.fakeMetadata(tvdbID))
+
+	return http.StatusOK, []fakeObject{lookup}
+}
+
+// serveSeriesImport adds all the series at once, failing if any of them is invalid.
+func (f *fakeSonarr) serveSeriesImport(r *http.Request, body interface{}) (int, interface{}) {
+	if r.Method != http.MethodPost {
+		return http.StatusMethodNotAllowed, "Method Not Allowed"
+	}
+
+	items, ok := body.([]interface{})
+	if !ok {
+		return http.StatusBadRequest, "Invalid request body"
+	}
+
+	objects := make([]fakeObject, len(items))
+	for i, item := range items {
+		object, ok := item.(fakeObject)
+		if !ok {
+			return http.StatusBadRequest, "Invalid request body"
+		}
+
+		if failures := f.validate("series", 0, object); failures != nil {
+			return http.StatusBadRequest, failures
+		}
+
+		tvdbID, _ := strconv.Atoi(fmt.Sprint(object["tvdbId"]))
+		object["title"] = fakeCatalogLookup(tvdbID).title
+		objects[i] = object
+	}
+
+	for i, object := range objects {
+		f.prepare("series", object)
+		objects[i] = f.addSeries(object)
+	}
+
+	return http.StatusAccepted, objects
+}
+
+// serveSeriesEditor updates or deletes many series at once.
+func (f *fakeSonarr) serveSeriesEditor(r *http.Request, body interface{}) (int, interface{}) {
+	editor, ok := body.(fakeObject)
+	if !ok {
+		return http.StatusBadRequest, "Invalid request body"
+	}
+
+	ids, _ := editor["seriesIds"].([]interface{})
+	series := make([]fakeObject, 0, len(ids))
+
+	for _, id := range ids {
+		id, _ := strconv.Atoi(fmt.Sprint(id))
+		if object, ok := f.collections["series"][id]; ok {
+			series = append(series, object)
+		}
+	}
+
+	switch r.Method {
+	case http.MethodPut:
+		moved := []interface{}{}
+
+		for _, object := range series {
+			for _, key := range []string{"monitored", "monitorNewItems", "qualityProfileId", "seriesType", "seasonFolder", "tags"} {
+				if value, ok := editor[key]; ok && value != nil {
+					object[key] = value
+				}
+			}
+
+			if root, ok := editor["rootFolderPath"].(string); ok && root != "" {
+				destination := root + "/" + path.Base(fmt.Sprint(object["path"]))
+				if destination != object["path"] {
+					moved = append(moved, fakeObject{"seriesId": object["id"], "sourcePath": object["path"], "destinationPath": destination})
+				}
+
+				object["path"] = destination
+				object["rootFolderPath"] = root
+			}
+		}
+
+		if editor["moveFiles"] == true && len(moved) > 0 {
+			f.insert("command", fakeObject{
+				"name":        "BulkMoveSeries",
+				"commandName": "Bulk Move Series",
+				"status":      "started",
+				"body":        fakeObject{"series": moved},
+			})
+		}
+
+		return http.StatusAccepted, series
+	case http.MethodDelete:
+		for _, object := range series {
+			id, _ := object["id"].(int)
+			f.deleteSeries(id, editor["addImportListExclusion"] == true)
+		}
+
+		return http.StatusOK, nil
+	}
+
+	return http.StatusMethodNotAllowed, "Method Not Allowed"
+}
+
+// serveEpisodeMonitor sets the monitored flag of many episodes at once.
+func (f *fakeSonarr) serveEpisodeMonitor(r *http.Request, body interface{}) (int, interface{}) {
+	if r.Method != http.MethodPut {
+		return http.StatusMethodNotAllowed, "Method Not Allowed"
+	}
+
+	request, ok := body.(fakeObject)
+	if !ok {
+		return http.StatusBadRequest, "Invalid request body"
+	}
+
+	ids, _ := request["episodeIds"].([]interface{})
+	for _, id := range ids {
+		id, _ := strconv.Atoi(fmt.Sprint(id))
+		if episode, ok := f.collections["episode"][id]; ok {
+			episode["monitored"] = request["monitored"]
+		}
+	}
+
+	return http.StatusAccepted, nil
+}
+
+// serveEpisodeFileEditor applies the edited fields to every listed episode file.
+func (f *fakeSonarr) serveEpisodeFileEditor(r *http.Request, body interface{}) (int, interface{}) {
+	if r.Method != http.MethodPut {
+		return http.StatusMethodNotAllowed, "Method Not Allowed"
+	}
+
+	request, ok := body.(fakeObject)
+	if !ok {
+		return http.StatusBadRequest, "Invalid request body"
+	}
+
+	ids, _ := request["episodeFileIds"].([]interface{})
+	for _, id := range ids {
+		id, _ := strconv.Atoi(fmt.Sprint(id))
+
+		file, ok := f.collections["episodefile"][id]
+		if !ok {
+			return http.StatusNotFound, "Not Found"
+		}
+
+		for _, field := range []string{"quality", "languages", "sceneName", "releaseGroup"} {
+			if value, ok := request[field]; ok && value != nil {
+				file[field] = value
+			}
+		}
+	}
+
+	return http.StatusAccepted, nil
+}
+
+// serveCalendar lists the episodes airing in the requested window, monitored only unless asked otherwise.
+func (f *fakeSonarr) serveCalendar(r *http.Request) (int, interface{}) {
+	if r.Method != http.MethodGet {
+		return http.StatusMethodNotAllowed, "Method Not Allowed"
+	}
+
+	query := r.URL.Query()
+	start, startErr := time.Parse(time.RFC3339, query.Get("start"))
+	end, endErr := time.Parse(time.RFC3339, query.Get("end"))
+
+	if startErr != nil || endErr != nil {
+		return http.StatusBadRequest, "Invalid calendar window"
+	}
+
+	var tags []string
+	if query.Get("tags") != "" {
+		tags = strings.Split(query.Get("tags"), ",")
+	}
+
+	episodes := make([]fakeObject, 0)
+
+	for _, episode := range f.list("episode") {
+		airDate, err := time.Parse(time.RFC3339, fmt.Sprint(episode["airDateUtc"]))
+		if err != nil || airDate.Before(start) || airDate.After(end) {
+			continue
+		}
+
+		if episode["monitored"] != true && query.Get("unmonitored") != "true" {
+			continue
+		}
+
+		seriesID, _ := strconv.Atoi(fmt.Sprint(episode["seriesId"]))
+		series := f.collections["series"][seriesID]
+		seriesTags, _ := series["tags"].([]interface{})
+
+		if tags != nil && !slices.ContainsFunc(seriesTags, func(tag interface{}) bool { return slices.Contains(tags, fmt.Sprint(tag)) }) {
+			continue
+		}
+
+		episode = maps.Clone(episode)
+		if query.Get("includeSeries") == "true" {
+			episode["series"] = series
+		}
+
+		episodes = append(episodes, episode)
+	}
+
+	return http.StatusOK, episodes
+}
+
+// fillFields adds to the object fields the default value of every field known for its implementation.
+func (p fakeProviderFamily) fillFields(object fakeObject) {
+	model, ok := p.implementations[fmt.Sprint(object["implementation"])]
+	if !ok {
+		return
+	}
+
+	fields, _ := object["fields"].([]interface{})
+	present := make(map[string]bool, len(fields))
+
+	for _, field := range fields {
+		if f, ok := field.(fakeObject); ok {
+			present[fmt.Sprint(f["name"])] = true
+		}
+	}
+
+	modelType := reflect.TypeOf(model)
+	for i := 0; i < modelType.NumField(); i++ {
+		name := fakeAPIFieldName(modelType.Field(i).Tag.Get("tfsdk"))
+		if value, ok := p.defaultValue(name); ok && !present[name] {
+			fields = append(fields, fakeObject{"name": name, "value": value})
+		}
+	}
+
+	object["fields"] = fields
+}
+
+// defaultValue returns the zero value of a field depending on its type list.
+func (p fakeProviderFamily) defaultValue(name string) (interface{}, bool) {
+	defaults := []struct {
+		value interface{}
+		lists [][]string
+	}{
+		{false, [][]string{p.fields.Bools, p.fields.BoolsExceptions}},
+		{0, [][]string{p.fields.Ints, p.fields.IntsExceptions}},
+		{0.0, [][]string{p.fields.Floats, p.fields.FloatsExceptions}},
+		{"", [][]string{p.fields.Strings, p.fields.StringsExceptions}},
+		{[]interface{}{}, [][]string{p.fields.StringSlices, p.fields.StringSlicesExceptions, p.fields.IntSlices, p.fields.IntSlicesExceptions}},
+	}
+
+	for _, d := range defaults {
+		for _, list := range d.lists {
+			if slices.Contains(list, name) {
+				return d.value, true
+			}
+		}
+	}
+
+	return nil, false
+}
+
+// fakeAPIFieldName converts a terraform attribute name into the Sonarr field name.
+func fakeAPIFieldName(tfName string) string {
+	parts := strings.Split(tfName, "_")
+	for i := 1; i < len(parts); i++ {
+		parts[i] = strings.ToUpper(parts[i][:1]) + parts[i][1:]
+	}
+
+	name := strings.Join(parts, "")
+
+	exceptions := map[string]string{
+		"fieldTags":          "tags",
+		"seedTime":           "seedCriteria.seedTime",
+		"seedRatio":          "seedCriteria.seedRatio",
+		"seasonPackSeedTime": "seedCriteria.seasonPackSeedTime",
+	}
+
+	if exception, ok := exceptions[name]; ok {
+		return exception
+	}
+
+	return name
+}
+
+func writeFakeJSON(w http.ResponseWriter, status int, body interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+
+	if body != nil {
+		_ = json.NewEncoder(w).Encode(body)
+	}
+}
+
+func writeFakeError(w http.ResponseWriter, status int, message string) {
+	writeFakeJSON(w, status, fakeObject{"message": message})
+}
@@ -418,9 +418,9 @@ func (r *HostResource) Create(ctx context.Context, req resource.CreateRequest, r
 	request.SetId(1)
 
 	// Create new Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, hostResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, hostResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -442,7 +442,7 @@ func (r *HostResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// Get host current value
-	response, _, err := r.client.HostConfigAPI.GetHostConfig(r.auth).Execute()
+	response, _, err := r.client.HostConfigAPI.GetHostConfig(helpers.WithAuth(ctx, r.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, hostResourceName, err))
 
@@ -469,9 +469,9 @@ func (r *HostResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	request := host.read(ctx, &resp.Diagnostics)
 
 	// Update Host
-	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(r.auth, strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
+	response, _, err := r.client.HostConfigAPI.UpdateHostConfig(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).HostConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, hostResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, hostResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -168,9 +168,9 @@ func (r *ImportListCustomResource) Create(ctx context.Context, req resource.Crea
 	// Create new ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListCustomResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListCustomResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -192,9 +192,9 @@ func (r *ImportListCustomResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get ImportListCustom current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListCustomResourceName, err))
+		helpers.HandleReadError(ctx, importListCustomResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -218,9 +218,9 @@ func (r *ImportListCustomResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListCustom
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListCustomResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListCustomResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -241,7 +241,7 @@ func (r *ImportListCustomResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete ImportListCustom current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListCustomResourceName, err))
 
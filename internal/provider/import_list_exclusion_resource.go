@@ -97,9 +97,9 @@ func (r *ImportListExclusionResource) Create(ctx context.Context, req resource.C
 	// Create new ImportListExclusion
 	request := importListExclusion.read()
 
-	response, _, err := r.client.ImportListExclusionAPI.CreateImportListExclusion(r.auth).ImportListExclusionResource(*request).Execute()
+	response, _, err := r.client.ImportListExclusionAPI.CreateImportListExclusion(helpers.WithAuth(ctx, r.auth)).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListExclusionResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListExclusionResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -121,9 +121,9 @@ func (r *ImportListExclusionResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get importListExclusion current value
-	response, _, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(r.auth, int32(importListExclusion.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListExclusionAPI.GetImportListExclusionById(helpers.WithAuth(ctx, r.auth), int32(importListExclusion.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListExclusionResourceName, err))
+		helpers.HandleReadError(ctx, importListExclusionResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -147,9 +147,9 @@ func (r *ImportListExclusionResource) Update(ctx context.Context, req resource.U
 	// Update ImportListExclusion
 	request := importListExclusion.read()
 
-	response, _, err := r.client.ImportListExclusionAPI.UpdateImportListExclusion(r.auth, strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
+	response, _, err := r.client.ImportListExclusionAPI.UpdateImportListExclusion(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListExclusionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListExclusionResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListExclusionResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -170,7 +170,7 @@ func (r *ImportListExclusionResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete importListExclusion current value
-	_, err := r.client.ImportListExclusionAPI.DeleteImportListExclusion(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListExclusionAPI.DeleteImportListExclusion(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListExclusionResourceName, err))
 
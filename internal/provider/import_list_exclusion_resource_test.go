@@ -46,6 +46,19 @@ func TestAccImportListExclusionResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccImportListExclusionResourceConfig("test", 1234),
+				Check:              testAccCheckResourceDisappears("sonarr_import_list_exclusion.test", "importlistexclusion"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccImportListExclusionResourceConfig("test", 1234),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_import_list_exclusion.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
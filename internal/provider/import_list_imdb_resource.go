@@ -168,9 +168,9 @@ func (r *ImportListImdbResource) Create(ctx context.Context, req resource.Create
 	// Create new ImportListImdb
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListImdbResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListImdbResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -192,9 +192,9 @@ func (r *ImportListImdbResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get ImportListImdb current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListImdbResourceName, err))
+		helpers.HandleReadError(ctx, importListImdbResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -218,9 +218,9 @@ func (r *ImportListImdbResource) Update(ctx context.Context, req resource.Update
 	// Update ImportListImdb
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListImdbResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListImdbResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -241,7 +241,7 @@ func (r *ImportListImdbResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete ImportListImdb current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListImdbResourceName, err))
 
@@ -169,9 +169,9 @@ func (r *ImportListPlexResource) Create(ctx context.Context, req resource.Create
 	// Create new ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListPlexResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -193,9 +193,9 @@ func (r *ImportListPlexResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get ImportListPlex current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListPlexResourceName, err))
+		helpers.HandleReadError(ctx, importListPlexResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -219,9 +219,9 @@ func (r *ImportListPlexResource) Update(ctx context.Context, req resource.Update
 	// Update ImportListPlex
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListPlexResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -242,7 +242,7 @@ func (r *ImportListPlexResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete ImportListPlex current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListPlexResourceName, err))
 
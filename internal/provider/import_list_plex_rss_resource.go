@@ -168,9 +168,9 @@ func (r *ImportListPlexRSSResource) Create(ctx context.Context, req resource.Cre
 	// Create new ImportListPlexRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListPlexRSSResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListPlexRSSResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -192,9 +192,9 @@ func (r *ImportListPlexRSSResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get ImportListPlexRSS current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListPlexRSSResourceName, err))
+		helpers.HandleReadError(ctx, importListPlexRSSResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -218,9 +218,9 @@ func (r *ImportListPlexRSSResource) Update(ctx context.Context, req resource.Upd
 	// Update ImportListPlexRSS
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListPlexRSSResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListPlexRSSResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -241,7 +241,7 @@ func (r *ImportListPlexRSSResource) Delete(ctx context.Context, req resource.Del
 	}
 
 	// Delete ImportListPlexRSS current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListPlexRSSResourceName, err))
 
@@ -307,9 +307,9 @@ func (r *ImportListResource) Create(ctx context.Context, req resource.CreateRequ
 	// Create new ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -334,9 +334,9 @@ func (r *ImportListResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get ImportList current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListResourceName, err))
+		helpers.HandleReadError(ctx, importListResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -363,9 +363,9 @@ func (r *ImportListResource) Update(ctx context.Context, req resource.UpdateRequ
 	// Update ImportList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -389,7 +389,7 @@ func (r *ImportListResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete ImportList current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListResourceName, err))
 
@@ -47,6 +47,19 @@ func TestAccImportListResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccImportListResourceConfig("importListResourceTest", "true"),
+				Check:              testAccCheckResourceDisappears("sonarr_import_list.test", "importlist"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccImportListResourceConfig("importListResourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_import_list.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
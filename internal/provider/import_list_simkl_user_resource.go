@@ -206,9 +206,9 @@ func (r *ImportListSimklUserResource) Create(ctx context.Context, req resource.C
 	// Create new ImportListSimklUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListSimklUserResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListSimklUserResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -230,9 +230,9 @@ func (r *ImportListSimklUserResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get ImportListSimklUser current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSimklUserResourceName, err))
+		helpers.HandleReadError(ctx, importListSimklUserResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -256,9 +256,9 @@ func (r *ImportListSimklUserResource) Update(ctx context.Context, req resource.U
 	// Update ImportListSimklUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListSimklUserResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListSimklUserResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -279,7 +279,7 @@ func (r *ImportListSimklUserResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete ImportListSimklUser current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListSimklUserResourceName, err))
 
@@ -203,9 +203,9 @@ func (r *ImportListSonarrResource) Create(ctx context.Context, req resource.Crea
 	// Create new ImportListSonarr
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListSonarrResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListSonarrResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -227,9 +227,9 @@ func (r *ImportListSonarrResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get ImportListSonarr current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListSonarrResourceName, err))
+		helpers.HandleReadError(ctx, importListSonarrResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -253,9 +253,9 @@ func (r *ImportListSonarrResource) Update(ctx context.Context, req resource.Upda
 	// Update ImportListSonarr
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListSonarrResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListSonarrResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -276,7 +276,7 @@ func (r *ImportListSonarrResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete ImportListSonarr current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListSonarrResourceName, err))
 
@@ -225,9 +225,9 @@ func (r *ImportListTraktListResource) Create(ctx context.Context, req resource.C
 	// Create new ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktListResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListTraktListResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -249,9 +249,9 @@ func (r *ImportListTraktListResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get ImportListTraktList current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktListResourceName, err))
+		helpers.HandleReadError(ctx, importListTraktListResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -275,9 +275,9 @@ func (r *ImportListTraktListResource) Update(ctx context.Context, req resource.U
 	// Update ImportListTraktList
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktListResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListTraktListResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -298,7 +298,7 @@ func (r *ImportListTraktListResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete ImportListTraktList current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktListResourceName, err))
 
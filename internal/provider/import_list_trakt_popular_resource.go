@@ -246,9 +246,9 @@ func (r *ImportListTraktPopularResource) Create(ctx context.Context, req resourc
 	// Create new ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktPopularResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListTraktPopularResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -270,9 +270,9 @@ func (r *ImportListTraktPopularResource) Read(ctx context.Context, req resource.
 	}
 
 	// Get ImportListTraktPopular current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktPopularResourceName, err))
+		helpers.HandleReadError(ctx, importListTraktPopularResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -296,9 +296,9 @@ func (r *ImportListTraktPopularResource) Update(ctx context.Context, req resourc
 	// Update ImportListTraktPopular
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktPopularResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListTraktPopularResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -319,7 +319,7 @@ func (r *ImportListTraktPopularResource) Delete(ctx context.Context, req resourc
 	}
 
 	// Delete ImportListTraktPopular current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktPopularResourceName, err))
 
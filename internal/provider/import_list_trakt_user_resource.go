@@ -229,9 +229,9 @@ func (r *ImportListTraktUserResource) Create(ctx context.Context, req resource.C
 	// Create new ImportListTraktUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.CreateImportList(r.auth).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.CreateImportList(helpers.WithAuth(ctx, r.auth)).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, importListTraktUserResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, importListTraktUserResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -253,9 +253,9 @@ func (r *ImportListTraktUserResource) Read(ctx context.Context, req resource.Rea
 	}
 
 	// Get ImportListTraktUser current value
-	response, _, err := r.client.ImportListAPI.GetImportListById(r.auth, int32(importList.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ImportListAPI.GetImportListById(helpers.WithAuth(ctx, r.auth), int32(importList.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, importListTraktUserResourceName, err))
+		helpers.HandleReadError(ctx, importListTraktUserResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -279,9 +279,9 @@ func (r *ImportListTraktUserResource) Update(ctx context.Context, req resource.U
 	// Update ImportListTraktUser
 	request := importList.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.ImportListAPI.UpdateImportList(r.auth, strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
+	response, _, err := r.client.ImportListAPI.UpdateImportList(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ImportListResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, importListTraktUserResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, importListTraktUserResourceName, err, importListFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -302,7 +302,7 @@ func (r *ImportListTraktUserResource) Delete(ctx context.Context, req resource.D
 	}
 
 	// Delete ImportListTraktUser current value
-	_, err := r.client.ImportListAPI.DeleteImportList(r.auth, int32(ID)).Execute()
+	_, err := r.client.ImportListAPI.DeleteImportList(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, importListTraktUserResourceName, err))
 
@@ -200,9 +200,9 @@ func (r *IndexerBroadcastheNetResource) Create(ctx context.Context, req resource
 	// Create new IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(helpers.WithAuth(ctx, r.auth)).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerBroadcastheNetResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, indexerBroadcastheNetResourceName, err, indexerFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -224,9 +224,9 @@ func (r *IndexerBroadcastheNetResource) Read(ctx context.Context, req resource.R
 	}
 
 	// Get IndexerBroadcastheNet current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(helpers.WithAuth(ctx, r.auth), int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerBroadcastheNetResourceName, err))
+		helpers.HandleReadError(ctx, indexerBroadcastheNetResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -250,9 +250,9 @@ func (r *IndexerBroadcastheNetResource) Update(ctx context.Context, req resource
 	// Update IndexerBroadcastheNet
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerBroadcastheNetResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, indexerBroadcastheNetResourceName, err, indexerFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -273,7 +273,7 @@ func (r *IndexerBroadcastheNetResource) Delete(ctx context.Context, req resource
 	}
 
 	// Delete IndexerBroadcastheNet current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerBroadcastheNetResourceName, err))
 
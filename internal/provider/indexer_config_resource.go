@@ -99,9 +99,9 @@ func (r *IndexerConfigResource) Create(ctx context.Context, req resource.CreateR
 	request.SetId(1)
 
 	// Create new IndexerConfig
-	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(r.auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
+	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerConfigResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, indexerConfigResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -123,7 +123,7 @@ func (r *IndexerConfigResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get indexerConfig current value
-	response, _, err := r.client.IndexerConfigAPI.GetIndexerConfig(r.auth).Execute()
+	response, _, err := r.client.IndexerConfigAPI.GetIndexerConfig(helpers.WithAuth(ctx, r.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerConfigResourceName, err))
 
@@ -150,9 +150,9 @@ func (r *IndexerConfigResource) Update(ctx context.Context, req resource.UpdateR
 	request := config.read()
 
 	// Update IndexerConfig
-	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(r.auth, strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
+	response, _, err := r.client.IndexerConfigAPI.UpdateIndexerConfig(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).IndexerConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerConfigResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, indexerConfigResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
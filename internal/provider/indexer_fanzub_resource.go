@@ -169,9 +169,9 @@ func (r *IndexerFanzubResource) Create(ctx context.Context, req resource.CreateR
 	// Create new IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(helpers.WithAuth(ctx, r.auth)).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFanzubResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, indexerFanzubResourceName, err, indexerFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -193,9 +193,9 @@ func (r *IndexerFanzubResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get IndexerFanzub current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(helpers.WithAuth(ctx, r.auth), int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFanzubResourceName, err))
+		helpers.HandleReadError(ctx, indexerFanzubResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -219,9 +219,9 @@ func (r *IndexerFanzubResource) Update(ctx context.Context, req resource.UpdateR
 	// Update IndexerFanzub
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFanzubResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, indexerFanzubResourceName, err, indexerFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -242,7 +242,7 @@ func (r *IndexerFanzubResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// Delete IndexerFanzub current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerFanzubResourceName, err))
 
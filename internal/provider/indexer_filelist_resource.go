@@ -226,9 +226,9 @@ func (r *IndexerFilelistResource) Create(ctx context.Context, req resource.Creat
 	// Create new IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(helpers.WithAuth(ctx, r.auth)).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerFilelistResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, indexerFilelistResourceName, err, indexerFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -250,9 +250,9 @@ func (r *IndexerFilelistResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get IndexerFilelist current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(helpers.WithAuth(ctx, r.auth), int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerFilelistResourceName, err))
+		helpers.HandleReadError(ctx, indexerFilelistResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -276,9 +276,9 @@ func (r *IndexerFilelistResource) Update(ctx context.Context, req resource.Updat
 	// Update IndexerFilelist
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerFilelistResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, indexerFilelistResourceName, err, indexerFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -299,7 +299,7 @@ func (r *IndexerFilelistResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Delete IndexerFilelist current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerFilelistResourceName, err))
 
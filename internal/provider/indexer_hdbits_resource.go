@@ -208,9 +208,9 @@ func (r *IndexerHdbitsResource) Create(ctx context.Context, req resource.CreateR
 	// Create new IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.CreateIndexer(r.auth).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.CreateIndexer(helpers.WithAuth(ctx, r.auth)).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, indexerHdbitsResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, indexerHdbitsResourceName, err, indexerFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -232,9 +232,9 @@ func (r *IndexerHdbitsResource) Read(ctx context.Context, req resource.ReadReque
 	}
 
 	// Get IndexerHdbits current value
-	response, _, err := r.client.IndexerAPI.GetIndexerById(r.auth, int32(indexer.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.IndexerAPI.GetIndexerById(helpers.WithAuth(ctx, r.auth), int32(indexer.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, indexerHdbitsResourceName, err))
+		helpers.HandleReadError(ctx, indexerHdbitsResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -258,9 +258,9 @@ func (r *IndexerHdbitsResource) Update(ctx context.Context, req resource.UpdateR
 	// Update IndexerHdbits
 	request := indexer.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.IndexerAPI.UpdateIndexer(r.auth, strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
+	response, _, err := r.client.IndexerAPI.UpdateIndexer(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).IndexerResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, indexerHdbitsResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, indexerHdbitsResourceName, err, indexerFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -281,7 +281,7 @@ func (r *IndexerHdbitsResource) Delete(ctx context.Context, req resource.DeleteR
 	}
 
 	// Delete IndexerHdbits current value
-	_, err := r.client.IndexerAPI.DeleteIndexer(r.auth, int32(ID)).Execute()
+	_, err := r.client.IndexerAPI.DeleteIndexer(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, indexerHdbitsResourceName, err))
 
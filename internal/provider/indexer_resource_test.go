@@ -53,6 +53,19 @@ func TestAccIndexerResource(t *testing.T) {
 				ImportStateVerify:       true,
 				ImportStateVerifyIgnore: []string{"passkey"},
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccIndexerResourceConfig("resourceTest", "true"),
+				Check:              testAccCheckResourceDisappears("sonarr_indexer.test", "indexer"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccIndexerResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_indexer.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"strings"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -35,6 +36,82 @@ type Language struct {
 	ID        types.Int64  `tfsdk:"id"`
 }
 
+// defaultLanguages is the static table of the languages built into Sonarr v4, mirroring its Language enum.
+// Their IDs are the same on every instance, TestDefaultLanguages pins them to the Sonarr definitions.
+var defaultLanguages = []struct {
+	name string
+	id   int32
+}{
+	{"Original", -2},
+	{"Any", -1},
+	{"Unknown", 0},
+	{"English", 1},
+	{"French", 2},
+	{"Spanish", 3},
+	{"German", 4},
+	{"Italian", 5},
+	{"Danish", 6},
+	{"Dutch", 7},
+	{"Japanese", 8},
+	{"Icelandic", 9},
+	{"Chinese", 10},
+	{"Russian", 11},
+	{"Polish", 12},
+	{"Vietnamese", 13},
+	{"Swedish", 14},
+	{"Norwegian", 15},
+	{"Finnish", 16},
+	{"Turkish", 17},
+	{"Portuguese", 18},
+	{"Flemish", 19},
+	{"Greek", 20},
+	{"Korean", 21},
+	{"Hungarian", 22},
+	{"Hebrew", 23},
+	{"Lithuanian", 24},
+	{"Czech", 25},
+	{"Arabic", 26},
+	{"Hindi", 27},
+	{"Bulgarian", 28},
+	{"Malayalam", 29},
+	{"Ukrainian", 30},
+	{"Slovak", 31},
+	{"Thai", 32},
+	{"Portuguese (Brazil)", 33},
+	{"Spanish (Latino)", 34},
+	{"Romanian", 35},
+	{"Latvian", 36},
+	{"Persian", 37},
+	{"Catalan", 38},
+	{"Croatian", 39},
+	{"Serbian", 40},
+	{"Bosnian", 41},
+	{"Estonian", 42},
+	{"Tamil", 43},
+	{"Indonesian", 44},
+	{"Macedonian", 45},
+	{"Slovenian", 46},
+	{"Azerbaijani", 47},
+	{"Uzbek", 48},
+	{"Malay", 49},
+	{"Urdu", 50},
+	{"Romansh", 51},
+	{"Georgian", 52},
+}
+
+// defaultLanguageResources returns the built-in languages in the API format.
+func defaultLanguageResources() []sonarr.LanguageResource {
+	languages := make([]sonarr.LanguageResource, len(defaultLanguages))
+
+	for i, language := range defaultLanguages {
+		languages[i].SetId(language.id)
+		languages[i].SetName(language.name)
+		languages[i].SetNameLower(strings.ToLower(language.name))
+	}
+
+	return languages
+}
+
 func (l Language) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -85,7 +162,7 @@ func (d *LanguageDataSource) Read(ctx context.Context, req datasource.ReadReques
 	}
 
 	// Get languages current value
-	response, _, err := d.client.LanguageAPI.ListLanguage(d.auth).Execute()
+	response, _, err := d.client.LanguageAPI.ListLanguage(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, languageDataSourceName, err))
 
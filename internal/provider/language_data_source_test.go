@@ -1,10 +1,12 @@
 package provider
 
 import (
+	"fmt"
 	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccLanguageDataSource(t *testing.T) {
@@ -36,3 +38,28 @@ data "sonarr_language" "test" {
 	name = "English"
 }
 `
+
+// TestDefaultLanguages pins the built-in languages to the Sonarr v4 definitions
+// (src/NzbDrone.Core/Languages/Language.cs).
+func TestDefaultLanguages(t *testing.T) {
+	t.Parallel()
+
+	expected := []string{
+		"-2 Original", "-1 Any", "0 Unknown", "1 English", "2 French", "3 Spanish", "4 German", "5 Italian",
+		"6 Danish", "7 Dutch", "8 Japanese", "9 Icelandic", "10 Chinese", "11 Russian", "12 Polish",
+		"13 Vietnamese", "14 Swedish", "15 Norwegian", "16 Finnish", "17 Turkish", "18 Portuguese",
+		"19 Flemish", "20 Greek", "21 Korean", "22 Hungarian", "23 Hebrew", "24 Lithuanian", "25 Czech",
+		"26 Arabic", "27 Hindi", "28 Bulgarian", "29 Malayalam", "30 Ukrainian", "31 Slovak", "32 Thai",
+		"33 Portuguese (Brazil)", "34 Spanish (Latino)", "35 Romanian", "36 Latvian", "37 Persian",
+		"38 Catalan", "39 Croatian", "40 Serbian", "41 Bosnian", "42 Estonian", "43 Tamil", "44 Indonesian",
+		"45 Macedonian", "46 Slovenian", "47 Azerbaijani", "48 Uzbek", "49 Malay", "50 Urdu", "51 Romansh",
+		"52 Georgian",
+	}
+
+	languages := make([]string, len(defaultLanguages))
+	for i, language := range defaultLanguages {
+		languages[i] = fmt.Sprintf("%d %s", language.id, language.name)
+	}
+
+	assert.Equal(t, expected, languages)
+}
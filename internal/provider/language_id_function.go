@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const languageIDFunctionName = "language_id"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &LanguageIDFunction{}
+
+func NewLanguageIDFunction() function.Function {
+	return &LanguageIDFunction{}
+}
+
+// LanguageIDFunction defines the language ID function implementation.
+type LanguageIDFunction struct{}
+
+func (f *LanguageIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = languageIDFunctionName
+}
+
+func (f *LanguageIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Language ID from name.",
+		MarkdownDescription: "Returns the ID of a language built into Sonarr (e.g. `English`). The ID comes from a static table of the Sonarr v4 built-in languages, without calling Sonarr. Refer to the `sonarr_languages` data source for the available names.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Language name.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *LanguageIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	var (
+		language Language
+		diags    diag.Diagnostics
+	)
+
+	language.find(name, defaultLanguageResources(), &diags)
+
+	if diags.HasError() {
+		resp.Error = function.NewArgumentFuncError(0, diags[0].Detail())
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, language.ID)
+}
@@ -0,0 +1,46 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccLanguageIDFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Not found testing
+			{
+				Config:      testAccLanguageIDFunctionConfig("Error"),
+				ExpectError: regexp.MustCompile("Unable to find language"),
+			},
+			// Read testing
+			{
+				Config: testAccLanguageIDFunctionConfig("French"),
+				Check:  resource.TestCheckOutput("test", "2"),
+			},
+			{
+				Config: testAccLanguageIDFunctionConfig("Portuguese (Brazil)"),
+				Check:  resource.TestCheckOutput("test", "33"),
+			},
+		},
+	})
+}
+
+func testAccLanguageIDFunctionConfig(name string) string {
+	return fmt.Sprintf(`
+	output "test" {
+		value = provider::sonarr::language_id("%s")
+	}
+	`, name)
+}
@@ -161,9 +161,9 @@ func (r *MetadataKodiResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(helpers.WithAuth(ctx, r.auth)).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataKodiResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, metadataKodiResourceName, err, metadataFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -185,9 +185,9 @@ func (r *MetadataKodiResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataKodi current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(helpers.WithAuth(ctx, r.auth), int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataKodiResourceName, err))
+		helpers.HandleReadError(ctx, metadataKodiResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -211,9 +211,9 @@ func (r *MetadataKodiResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataKodi
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataKodiResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, metadataKodiResourceName, err, metadataFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -234,7 +234,7 @@ func (r *MetadataKodiResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete MetadataKodi current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataKodiResourceName, err))
 
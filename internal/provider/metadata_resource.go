@@ -166,9 +166,9 @@ func (r *MetadataResource) Create(ctx context.Context, req resource.CreateReques
 	// Create new Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(helpers.WithAuth(ctx, r.auth)).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, metadataResourceName, err, metadataFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -193,9 +193,9 @@ func (r *MetadataResource) Read(ctx context.Context, req resource.ReadRequest, r
 	}
 
 	// Get Metadata current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(helpers.WithAuth(ctx, r.auth), int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataResourceName, err))
+		helpers.HandleReadError(ctx, metadataResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -222,9 +222,9 @@ func (r *MetadataResource) Update(ctx context.Context, req resource.UpdateReques
 	// Update Metadata
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, metadataResourceName, err, metadataFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -248,7 +248,7 @@ func (r *MetadataResource) Delete(ctx context.Context, req resource.DeleteReques
 	}
 
 	// Delete Metadata current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataResourceName, err))
 
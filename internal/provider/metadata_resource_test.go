@@ -46,6 +46,19 @@ func TestAccMetadataResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccMetadataResourceConfig("resourceTest", "false"),
+				Check:              testAccCheckResourceDisappears("sonarr_metadata.test", "metadata"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccMetadataResourceConfig("resourceTest", "false"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_metadata.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -147,9 +147,9 @@ func (r *MetadataRoksboxResource) Create(ctx context.Context, req resource.Creat
 	// Create new MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(helpers.WithAuth(ctx, r.auth)).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataRoksboxResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, metadataRoksboxResourceName, err, metadataFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -171,9 +171,9 @@ func (r *MetadataRoksboxResource) Read(ctx context.Context, req resource.ReadReq
 	}
 
 	// Get MetadataRoksbox current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(helpers.WithAuth(ctx, r.auth), int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataRoksboxResourceName, err))
+		helpers.HandleReadError(ctx, metadataRoksboxResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -197,9 +197,9 @@ func (r *MetadataRoksboxResource) Update(ctx context.Context, req resource.Updat
 	// Update MetadataRoksbox
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataRoksboxResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, metadataRoksboxResourceName, err, metadataFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -220,7 +220,7 @@ func (r *MetadataRoksboxResource) Delete(ctx context.Context, req resource.Delet
 	}
 
 	// Delete MetadataRoksbox current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataRoksboxResourceName, err))
 
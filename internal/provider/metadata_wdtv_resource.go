@@ -147,9 +147,9 @@ func (r *MetadataWdtvResource) Create(ctx context.Context, req resource.CreateRe
 	// Create new MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.CreateMetadata(r.auth).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.CreateMetadata(helpers.WithAuth(ctx, r.auth)).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, metadataWdtvResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, metadataWdtvResourceName, err, metadataFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -171,9 +171,9 @@ func (r *MetadataWdtvResource) Read(ctx context.Context, req resource.ReadReques
 	}
 
 	// Get MetadataWdtv current value
-	response, _, err := r.client.MetadataAPI.GetMetadataById(r.auth, int32(metadata.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.MetadataAPI.GetMetadataById(helpers.WithAuth(ctx, r.auth), int32(metadata.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, metadataWdtvResourceName, err))
+		helpers.HandleReadError(ctx, metadataWdtvResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -197,9 +197,9 @@ func (r *MetadataWdtvResource) Update(ctx context.Context, req resource.UpdateRe
 	// Update MetadataWdtv
 	request := metadata.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.MetadataAPI.UpdateMetadata(r.auth, strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
+	response, _, err := r.client.MetadataAPI.UpdateMetadata(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).MetadataResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, metadataWdtvResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, metadataWdtvResourceName, err, metadataFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -220,7 +220,7 @@ func (r *MetadataWdtvResource) Delete(ctx context.Context, req resource.DeleteRe
 	}
 
 	// Delete MetadataWdtv current value
-	_, err := r.client.MetadataAPI.DeleteMetadata(r.auth, int32(ID)).Execute()
+	_, err := r.client.MetadataAPI.DeleteMetadata(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, metadataWdtvResourceName, err))
 
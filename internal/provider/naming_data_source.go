@@ -91,7 +91,7 @@ func (d *NamingDataSource) Configure(ctx context.Context, req datasource.Configu
 
 func (d *NamingDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get naming current value
-	response, _, err := d.client.NamingConfigAPI.GetNamingConfig(d.auth).Execute()
+	response, _, err := d.client.NamingConfigAPI.GetNamingConfig(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingDataSourceName, err))
 
@@ -133,7 +133,7 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 	}
 
 	// Init call if we remove this it the very first update on a brand new instance will fail
-	if _, _, err := r.client.NamingConfigAPI.GetNamingConfig(r.auth).Execute(); err != nil {
+	if _, _, err := r.client.NamingConfigAPI.GetNamingConfig(helpers.WithAuth(ctx, r.auth)).Execute(); err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError("init", namingResourceName, err))
 
 		return
@@ -144,9 +144,9 @@ func (r *NamingResource) Create(ctx context.Context, req resource.CreateRequest,
 	request.SetId(1)
 
 	// Create new Naming
-	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(r.auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
+	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, namingResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, namingResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -168,7 +168,7 @@ func (r *NamingResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Get naming current value
-	response, _, err := r.client.NamingConfigAPI.GetNamingConfig(r.auth).Execute()
+	response, _, err := r.client.NamingConfigAPI.GetNamingConfig(helpers.WithAuth(ctx, r.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, namingResourceName, err))
 
@@ -195,9 +195,9 @@ func (r *NamingResource) Update(ctx context.Context, req resource.UpdateRequest,
 	request := naming.read()
 
 	// Update Naming
-	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(r.auth, strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
+	response, _, err := r.client.NamingConfigAPI.UpdateNamingConfig(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).NamingConfigResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, namingResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, namingResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
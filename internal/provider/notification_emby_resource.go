@@ -254,9 +254,9 @@ func (r *NotificationEmbyResource) Create(ctx context.Context, req resource.Crea
 	// Create new NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.CreateNotification(r.auth).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.CreateNotification(helpers.WithAuth(ctx, r.auth)).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, notificationEmbyResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, notificationEmbyResourceName, err, notificationFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -278,9 +278,9 @@ func (r *NotificationEmbyResource) Read(ctx context.Context, req resource.ReadRe
 	}
 
 	// Get NotificationEmby current value
-	response, _, err := r.client.NotificationAPI.GetNotificationById(r.auth, int32(notification.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.NotificationAPI.GetNotificationById(helpers.WithAuth(ctx, r.auth), int32(notification.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, notificationEmbyResourceName, err))
+		helpers.HandleReadError(ctx, notificationEmbyResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -304,9 +304,9 @@ func (r *NotificationEmbyResource) Update(ctx context.Context, req resource.Upda
 	// Update NotificationEmby
 	request := notification.read(ctx, &resp.Diagnostics)
 
-	response, _, err := r.client.NotificationAPI.UpdateNotification(r.auth, strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
+	response, _, err := r.client.NotificationAPI.UpdateNotification(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).NotificationResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, notificationEmbyResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, notificationEmbyResourceName, err, notificationFields, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -327,7 +327,7 @@ func (r *NotificationEmbyResource) Delete(ctx context.Context, req resource.Dele
 	}
 
 	// Delete NotificationEmby current value
-	_, err := r.client.NotificationAPI.DeleteNotification(r.auth, int32(ID)).Execute()
+	_, err := r.client.NotificationAPI.DeleteNotification(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, notificationEmbyResourceName, err))
 
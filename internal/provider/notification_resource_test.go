@@ -46,6 +46,19 @@ func TestAccNotificationResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccNotificationResourceConfig("resourceTest", "true"),
+				Check:              testAccCheckResourceDisappears("sonarr_notification.test", "notification"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccNotificationResourceConfig("resourceTest", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_notification.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -3,24 +3,50 @@ package provider
 import (
 	"context"
 	"fmt"
+	"net/http"
 	"net/url"
 	"os"
+	"strconv"
 	"strings"
+	"time"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = 1
+	defaultRetryWaitMax = 30
+	defaultWaitTimeout  = 300
+	defaultWaitInterval = 5
+	defaultMaxWrites    = 0
+	// versionProbeTimeout bounds the Sonarr version detection when not waiting for Sonarr to be ready.
+	versionProbeTimeout = 5 * time.Second
 )
 
 // needed for tf debug mode
 // var stderr = os.Stderr
 
 // Ensure provider defined types fully satisfy framework interfaces.
-var _ provider.Provider = &SonarrProvider{}
+var (
+	_ provider.Provider              = &SonarrProvider{}
+	_ provider.ProviderWithFunctions = &SonarrProvider{}
+)
 
 // SonarrProvider defines the provider implementation.
 type SonarrProvider struct {
@@ -32,9 +58,21 @@ type SonarrProvider struct {
 
 // Sonarr describes the provider data model.
 type Sonarr struct {
-	ExtraHeaders types.Set    `tfsdk:"extra_headers"`
-	APIKey       types.String `tfsdk:"api_key"`
-	URL          types.String `tfsdk:"url"`
+	ExtraHeaders        types.Set    `tfsdk:"extra_headers"`
+	APIKey              types.String `tfsdk:"api_key"`
+	APIKeyFile          types.String `tfsdk:"api_key_file"`
+	ConfigXMLPath       types.String `tfsdk:"config_xml_path"`
+	URL                 types.String `tfsdk:"url"`
+	CACertificate       types.String `tfsdk:"ca_certificate"`
+	CACertificateFile   types.String `tfsdk:"ca_certificate_file"`
+	ClientCertificate   types.String `tfsdk:"client_certificate"`
+	ClientKey           types.String `tfsdk:"client_key"`
+	MaxRetries          types.Int64  `tfsdk:"max_retries"`
+	RetryWaitMin        types.Int64  `tfsdk:"retry_wait_min"`
+	RetryWaitMax        types.Int64  `tfsdk:"retry_wait_max"`
+	MaxConcurrentWrites types.Int64  `tfsdk:"max_concurrent_writes"`
+	WaitForReady        types.Object `tfsdk:"wait_for_ready"`
+	InsecureSkipVerify  types.Bool   `tfsdk:"insecure_skip_verify"`
 }
 
 // ExtraHeader is part of Sonarr.
@@ -43,10 +81,18 @@ type ExtraHeader struct {
 	Value types.String `tfsdk:"value"`
 }
 
+// WaitForReady is part of Sonarr.
+type WaitForReady struct {
+	Timeout      types.Int64 `tfsdk:"timeout"`
+	PollInterval types.Int64 `tfsdk:"poll_interval"`
+}
+
 // SonarrData defines auth and client to be used when connecting to Sonarr.
+// Version is nil if it could not be detected.
 type SonarrData struct {
-	Auth   context.Context
-	Client *sonarr.APIClient
+	Auth    context.Context
+	Client  *sonarr.APIClient
+	Version *version.Version
 }
 
 func (p *SonarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -56,15 +102,26 @@ func (p *SonarrProvider) Metadata(_ context.Context, _ provider.MetadataRequest,
 
 func (p *SonarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, resp *provider.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "The Sonarr provider is used to interact with any [Sonarr](https://sonarr.tv/) installation.\nYou must configure the provider with the proper [credentials](#api_key) before you can use it.\nUse the left navigation to read about the available resources.\n\nFor more information about Sonarr and its resources, as well as configuration guides and hints, visit the [Servarr wiki](https://wiki.servarr.com/en/sonarr).",
+		MarkdownDescription: "The Sonarr provider is used to interact with any [Sonarr](https://sonarr.tv/) installation.\nYou must configure the provider with the proper [credentials](#api_key) before you can use it.\nUse the left navigation to read about the available resources.\n\nFor more information about Sonarr and its resources, as well as configuration guides and hints, visit the [Servarr wiki](https://wiki.servarr.com/en/sonarr).\n\nAPI requests and responses are logged in the `sonarr_api` subsystem, with method, URL, status and latency at `DEBUG` level and headers and bodies at `TRACE` level (e.g. `TF_LOG_PROVIDER=TRACE`). API key, extra headers values and sensitive fields are redacted.",
 		Attributes: map[string]schema.Attribute{
 			"api_key": schema.StringAttribute{
 				MarkdownDescription: "API key for Sonarr authentication. Can be specified via the `SONARR_API_KEY` environment variable.",
 				Optional:            true,
 				Sensitive:           true,
 			},
+			"api_key_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a file containing the API key for Sonarr authentication (e.g. a mounted secret). Can be specified via the `SONARR_API_KEY_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("api_key")),
+				},
+			},
+			"config_xml_path": schema.StringAttribute{
+				MarkdownDescription: "Path to the Sonarr `config.xml` to read the API key and URL base from. If no URL is set, it is built from the config to reach Sonarr on the local host. Can be specified via the `SONARR_CONFIG_XML_PATH` environment variable.\n\nThe API key is taken, in order of precedence, from `api_key`, `api_key_file` and `config_xml_path` attributes, then from `SONARR_API_KEY`, `SONARR_API_KEY_FILE` and `SONARR_CONFIG_XML_PATH` environment variables. The URL base from the config is used only when the URL has no path.",
+				Optional:            true,
+			},
 			"url": schema.StringAttribute{
-				MarkdownDescription: "Full Sonarr URL with protocol and port (e.g. `https://test.sonarr.tv:8989`). You should **NOT** supply any path (`/api`), the SDK will use the appropriate paths. Can be specified via the `SONARR_URL` environment variable.",
+				MarkdownDescription: "Full Sonarr URL with protocol, port and URL base if any (e.g. `https://test.sonarr.tv:8989` or `https://media.example.com/sonarr`). You should **NOT** supply the API path (`/api`), the SDK will use the appropriate paths. Can be specified via the `SONARR_URL` environment variable.",
 				Optional:            true,
 			},
 			"extra_headers": schema.SetNestedAttribute{
@@ -83,6 +140,87 @@ func (p *SonarrProvider) Schema(_ context.Context, _ provider.SchemaRequest, res
 					},
 				},
 			},
+			"ca_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificate trusted along with the system ones to verify the Sonarr server certificate. Can be specified via the `SONARR_CA_CERTIFICATE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate_file")),
+				},
+			},
+			"ca_certificate_file": schema.StringAttribute{
+				MarkdownDescription: "Path to a PEM encoded CA certificate file trusted along with the system ones to verify the Sonarr server certificate. Can be specified via the `SONARR_CA_CERTIFICATE_FILE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("ca_certificate")),
+				},
+			},
+			"client_certificate": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client certificate for mutual TLS authentication. Requires `client_key`. Can be specified via the `SONARR_CLIENT_CERTIFICATE` environment variable.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_key")),
+				},
+			},
+			"client_key": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded client private key for mutual TLS authentication. Requires `client_certificate`. Can be specified via the `SONARR_CLIENT_KEY` environment variable.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_certificate")),
+				},
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Skip the verification of the Sonarr server certificate. **NOT** recommended outside testing environments. Can be specified via the `SONARR_INSECURE_SKIP_VERIFY` environment variable.",
+				Optional:            true,
+			},
+			"wait_for_ready": schema.SingleNestedAttribute{
+				MarkdownDescription: "Wait for Sonarr to be ready, polling the system status with the configured API key, before managing any resource or data source. Useful when Sonarr is deployed in the same apply.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"timeout": schema.Int64Attribute{
+						MarkdownDescription: "Maximum time in seconds to wait for Sonarr. Defaults to `300`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+					"poll_interval": schema.Int64Attribute{
+						MarkdownDescription: "Time in seconds between status checks. Defaults to `5`.",
+						Optional:            true,
+						Validators: []validator.Int64{
+							int64validator.AtLeast(1),
+						},
+					},
+				},
+			},
+			"max_retries": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of retries for transient Sonarr API failures (connection errors, `429` and `5xx` responses). Non idempotent requests are retried only when they were not processed by Sonarr. Defaults to `3`, set `0` to disable. Can be specified via the `SONARR_MAX_RETRIES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_min": schema.Int64Attribute{
+				MarkdownDescription: "Minimum time in seconds to wait before retrying a request, doubled on each attempt. Defaults to `1`. Can be specified via the `SONARR_RETRY_WAIT_MIN` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"retry_wait_max": schema.Int64Attribute{
+				MarkdownDescription: "Maximum time in seconds to wait before retrying a request. Defaults to `30`. Can be specified via the `SONARR_RETRY_WAIT_MAX` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
+			"max_concurrent_writes": schema.Int64Attribute{
+				MarkdownDescription: "Maximum number of concurrent write requests (create, update and delete) sent to Sonarr, to avoid `database is locked` errors from its SQLite database with high `-parallelism` (e.g. `1` to serialize them). Reads are never limited. Defaults to `0`, which does not limit writes. Can be specified via the `SONARR_MAX_CONCURRENT_WRITES` environment variable.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(0),
+				},
+			},
 		},
 	}
 }
@@ -96,6 +234,23 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	// Read Sonarr config.xml
+	var sonarrConfig *helpers.SonarrConfig
+
+	if configPath := stringConfigValue(data.ConfigXMLPath, "SONARR_CONFIG_XML_PATH"); configPath != "" {
+		var err error
+
+		sonarrConfig, err = helpers.ReadSonarrConfig(configPath)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Unable to read Sonarr config.xml",
+				err.Error(),
+			)
+
+			return
+		}
+	}
+
 	// Extract URL
 	APIURL := data.URL.ValueString()
 	if APIURL == "" {
@@ -112,10 +267,20 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		return
 	}
 
+	if sonarrConfig != nil {
+		parsedAPIURL = sonarrConfig.CompleteURL(parsedAPIURL)
+		APIURL = parsedAPIURL.String()
+	}
+
 	// Extract key
-	key := data.APIKey.ValueString()
-	if key == "" {
-		key = os.Getenv("SONARR_API_KEY")
+	key, err := apiKey(data, sonarrConfig)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to read API key file",
+			err.Error(),
+		)
+
+		return
 	}
 
 	if key == "" {
@@ -147,6 +312,73 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 		}
 	}
 
+	// Setup TLS
+	tlsConfig, err := helpers.TLSOptions{
+		CACertificate:      stringConfigValue(data.CACertificate, "SONARR_CA_CERTIFICATE"),
+		CACertificateFile:  stringConfigValue(data.CACertificateFile, "SONARR_CA_CERTIFICATE_FILE"),
+		ClientCertificate:  stringConfigValue(data.ClientCertificate, "SONARR_CLIENT_CERTIFICATE"),
+		ClientKey:          stringConfigValue(data.ClientKey, "SONARR_CLIENT_KEY"),
+		InsecureSkipVerify: boolConfigValue(data.InsecureSkipVerify, "SONARR_INSECURE_SKIP_VERIFY", &resp.Diagnostics),
+	}.Config()
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Invalid TLS configuration",
+			err.Error(),
+		)
+
+		return
+	}
+
+	transport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unable to setup HTTP transport",
+			fmt.Sprintf("Expected *http.Transport, got: %T. Please report this issue to the provider developers.", http.DefaultTransport),
+		)
+
+		return
+	}
+
+	transport = transport.Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	// Trace API calls hiding API key and extra headers
+	secrets := []string{key}
+	for _, value := range config.DefaultHeader {
+		secrets = append(secrets, value)
+	}
+
+	loggingTransport := helpers.NewLoggingTransport(transport, secrets...)
+
+	// Limit the concurrent writes to protect the Sonarr database, if requested
+	writeLimitTransport := helpers.NewWriteLimitTransport(
+		loggingTransport,
+		int(int64ConfigValue(data.MaxConcurrentWrites, "SONARR_MAX_CONCURRENT_WRITES", defaultMaxWrites, &resp.Diagnostics)),
+	)
+
+	// Retry transient failures
+	retryTransport := &helpers.RetryTransport{
+		Transport:  writeLimitTransport,
+		MaxRetries: int(int64ConfigValue(data.MaxRetries, "SONARR_MAX_RETRIES", defaultMaxRetries, &resp.Diagnostics)),
+		WaitMin:    time.Duration(int64ConfigValue(data.RetryWaitMin, "SONARR_RETRY_WAIT_MIN", defaultRetryWaitMin, &resp.Diagnostics)) * time.Second,
+		WaitMax:    time.Duration(int64ConfigValue(data.RetryWaitMax, "SONARR_RETRY_WAIT_MAX", defaultRetryWaitMax, &resp.Diagnostics)) * time.Second,
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if retryTransport.WaitMax < retryTransport.WaitMin {
+		resp.Diagnostics.AddError(
+			"Invalid retry configuration",
+			"retry_wait_max cannot be lower than retry_wait_min",
+		)
+
+		return
+	}
+
+	config.HTTPClient = &http.Client{Transport: retryTransport}
+
 	// Set context for API calls
 	auth := context.WithValue(
 		context.Background(),
@@ -157,12 +389,43 @@ func (p *SonarrProvider) Configure(ctx context.Context, req provider.ConfigureRe
 	)
 	auth = context.WithValue(auth, sonarr.ContextServerVariables, map[string]string{
 		"protocol": parsedAPIURL.Scheme,
-		"hostpath": parsedAPIURL.Host,
+		"hostpath": parsedAPIURL.Host + strings.TrimSuffix(parsedAPIURL.EscapedPath(), "/"),
 	})
 
+	client := sonarr.NewAPIClient(config)
+
+	var status *sonarr.SystemResource
+
+	// Wait for Sonarr to be ready
+	if !data.WaitForReady.IsNull() {
+		wait := WaitForReady{}
+		resp.Diagnostics.Append(data.WaitForReady.As(ctx, &wait, basetypes.ObjectAsOptions{})...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		timeout := time.Duration(int64ValueOrDefault(wait.Timeout, defaultWaitTimeout)) * time.Second
+		interval := time.Duration(int64ValueOrDefault(wait.PollInterval, defaultWaitInterval)) * time.Second
+
+		status, err = waitForReady(ctx, auth, client, timeout, interval)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Sonarr not ready",
+				fmt.Sprintf("Sonarr at %s was not ready after %s: %s", APIURL, timeout, err),
+			)
+
+			return
+		}
+	} else {
+		// Errors are reported by the API calls of resources and data sources
+		status = probeStatus(ctx, auth, config, loggingTransport)
+	}
+
 	sonarrData := SonarrData{
-		Auth:   auth,
-		Client: sonarr.NewAPIClient(config),
+		Auth:    auth,
+		Client:  client,
+		Version: parseSonarrVersion(ctx, status),
 	}
 	resp.DataSourceData = &sonarrData
 	resp.ResourceData = &sonarrData
@@ -265,6 +528,9 @@ func (p *SonarrProvider) Resources(_ context.Context) []func() resource.Resource
 
 		// Series
 		NewSeriesResource,
+		NewSeriesCollectionResource,
+		NewEpisodeMonitoringResource,
+		NewEpisodeFileQualityResource,
 
 		// System
 		NewHostResource,
@@ -333,6 +599,10 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 		NewSeriesDataSource,
 		NewAllSeriessDataSource,
 		NewSearchSeriesDataSource,
+		NewEpisodeDataSource,
+		NewEpisodesDataSource,
+		NewEpisodeFilesDataSource,
+		NewCalendarDataSource,
 
 		// System
 		NewLanguageDataSource,
@@ -352,6 +622,13 @@ func (p *SonarrProvider) DataSources(_ context.Context) []func() datasource.Data
 	}
 }
 
+func (p *SonarrProvider) Functions(_ context.Context) []func() function.Function {
+	return []func() function.Function{
+		NewQualityIDFunction,
+		NewLanguageIDFunction,
+	}
+}
+
 // New returns the provider with a specific version.
 func New(version string) func() provider.Provider {
 	return func() provider.Provider {
@@ -361,11 +638,174 @@ func New(version string) func() provider.Provider {
 	}
 }
 
+// apiKey extracts the API key. Provider attributes take precedence over environment variables,
+// for both the order is: API key, API key file, config.xml.
+func apiKey(data Sonarr, sonarrConfig *helpers.SonarrConfig) (string, error) {
+	if key := data.APIKey.ValueString(); key != "" {
+		return key, nil
+	}
+
+	if file := data.APIKeyFile.ValueString(); file != "" {
+		return helpers.ReadAPIKeyFile(file)
+	}
+
+	if sonarrConfig != nil && data.ConfigXMLPath.ValueString() != "" {
+		return sonarrConfig.APIKey, nil
+	}
+
+	if key := os.Getenv("SONARR_API_KEY"); key != "" {
+		return key, nil
+	}
+
+	if file := os.Getenv("SONARR_API_KEY_FILE"); file != "" {
+		return helpers.ReadAPIKeyFile(file)
+	}
+
+	if sonarrConfig != nil {
+		return sonarrConfig.APIKey, nil
+	}
+
+	return "", nil
+}
+
+// waitForReady polls the Sonarr system status until it answers successfully.
+// An invalid API key is reported immediately, since waiting would not fix it.
+func waitForReady(ctx, auth context.Context, client *sonarr.APIClient, timeout, interval time.Duration) (*sonarr.SystemResource, error) {
+	auth, cancel := context.WithTimeout(helpers.WithAuth(ctx, auth), timeout)
+	defer cancel()
+
+	for {
+		status, httpResp, err := client.SystemAPI.GetSystemStatus(auth).Execute()
+		if err == nil {
+			return status, nil
+		}
+
+		if httpResp != nil && httpResp.StatusCode == http.StatusUnauthorized {
+			return nil, fmt.Errorf("%w: invalid API key", err)
+		}
+
+		tflog.Debug(ctx, "waiting for Sonarr to be ready: "+err.Error())
+
+		select {
+		case <-auth.Done():
+			return nil, err
+		case <-time.After(interval):
+		}
+	}
+}
+
+// probeStatus gets the system status once, skipping the retries, so that an unreachable Sonarr
+// does not delay the commands not calling it. It returns nil on failure.
+func probeStatus(ctx, auth context.Context, config *sonarr.Configuration, transport http.RoundTripper) *sonarr.SystemResource {
+	probeConfig := *config
+	probeConfig.HTTPClient = &http.Client{Transport: transport, Timeout: versionProbeTimeout}
+
+	status, _, err := sonarr.NewAPIClient(&probeConfig).SystemAPI.GetSystemStatus(helpers.WithAuth(ctx, auth)).Execute()
+	if err != nil {
+		tflog.Warn(ctx, "unable to detect Sonarr version: "+err.Error())
+
+		return nil
+	}
+
+	return status
+}
+
+// parseSonarrVersion extracts the Sonarr version from the system status, nil if unknown.
+func parseSonarrVersion(ctx context.Context, status *sonarr.SystemResource) *version.Version {
+	if status == nil {
+		return nil
+	}
+
+	parsed, err := version.NewVersion(status.GetVersion())
+	if err != nil {
+		tflog.Warn(ctx, "unable to parse Sonarr version: "+err.Error())
+
+		return nil
+	}
+
+	return parsed
+}
+
+// int64ValueOrDefault returns the value if set, the default otherwise.
+func int64ValueOrDefault(value types.Int64, fallback int64) int64 {
+	if value.IsNull() || value.IsUnknown() {
+		return fallback
+	}
+
+	return value.ValueInt64()
+}
+
+// stringConfigValue returns the configured value, falling back to the environment variable.
+func stringConfigValue(value types.String, env string) string {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueString()
+	}
+
+	return os.Getenv(env)
+}
+
+// boolConfigValue returns the configured value, falling back to the environment variable and then to false.
+func boolConfigValue(value types.Bool, env string, diags *diag.Diagnostics) bool {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueBool()
+	}
+
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return false
+	}
+
+	parsed, err := strconv.ParseBool(envValue)
+	if err != nil {
+		diags.AddError(
+			"Invalid environment variable",
+			fmt.Sprintf("%s must be a boolean, got: %s", env, envValue),
+		)
+
+		return false
+	}
+
+	return parsed
+}
+
+// int64ConfigValue returns the configured value, falling back to the environment variable and then to the default.
+func int64ConfigValue(value types.Int64, env string, fallback int64, diags *diag.Diagnostics) int64 {
+	if !value.IsNull() && !value.IsUnknown() {
+		return value.ValueInt64()
+	}
+
+	envValue := os.Getenv(env)
+	if envValue == "" {
+		return fallback
+	}
+
+	parsed, err := strconv.ParseInt(envValue, 10, 64)
+	if err != nil || parsed < 0 {
+		diags.AddError(
+			"Invalid environment variable",
+			fmt.Sprintf("%s must be a non negative integer, got: %s", env, envValue),
+		)
+
+		return fallback
+	}
+
+	return parsed
+}
+
 // ResourceConfigure is a helper function to set the client for a specific resource.
-func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
+func resourceConfigure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
+	if providerData := resourceConfigureData(ctx, req, resp); providerData != nil {
+		return providerData.Auth, providerData.Client
+	}
+
+	return nil, nil
+}
+
+// resourceConfigureData is a helper function to get the provider data for resources needing more than the client.
+func resourceConfigureData(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) *SonarrData {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
-		return nil, nil
+		return nil
 	}
 
 	providerData, ok := req.ProviderData.(*SonarrData)
@@ -375,16 +815,25 @@ func resourceConfigure(_ context.Context, req resource.ConfigureRequest, resp *r
 			fmt.Sprintf("Expected *SonarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
-		return nil, nil
+		return nil
+	}
+
+	return providerData
+}
+
+func dataSourceConfigure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
+	if providerData := dataSourceConfigureData(ctx, req, resp); providerData != nil {
+		return providerData.Auth, providerData.Client
 	}
 
-	return providerData.Auth, providerData.Client
+	return nil, nil
 }
 
-func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) (context.Context, *sonarr.APIClient) {
+// dataSourceConfigureData is a helper function to get the provider data for data sources needing more than the client.
+func dataSourceConfigureData(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) *SonarrData {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
-		return nil, nil
+		return nil
 	}
 
 	providerData, ok := req.ProviderData.(*SonarrData)
@@ -394,8 +843,8 @@ func dataSourceConfigure(_ context.Context, req datasource.ConfigureRequest, res
 			fmt.Sprintf("Expected *SonarrData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
-		return nil, nil
+		return nil
 	}
 
-	return providerData.Auth, providerData.Client
+	return providerData
 }
@@ -1,12 +1,27 @@
 package provider
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net/http"
 	"os"
+	"path/filepath"
+	"regexp"
 	"testing"
+	"time"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -17,6 +32,477 @@ var testAccProtoV6ProviderFactories = map[string]func() (tfprotov6.ProviderServe
 	"sonarr": providerserver.NewProtocol6WithError(New("test")()),
 }
 
+// TestMain points acceptance tests to an in-process fake Sonarr
+// unless a real instance is configured via SONARR_URL.
+func TestMain(m *testing.M) {
+	var server *fakeSonarr
+
+	if os.Getenv("SONARR_URL") == "" {
+		server = newFakeSonarr(fakeSonarrAPIKey, "")
+
+		os.Setenv("SONARR_URL", server.URL())
+		os.Setenv("SONARR_API_KEY", fakeSonarrAPIKey)
+	}
+
+	testUnauthorizedProvider = fmt.Sprintf(testUnauthorizedProviderConfig, os.Getenv("SONARR_URL"))
+	code := m.Run()
+
+	if server != nil {
+		server.Close()
+	}
+
+	os.Exit(code)
+}
+
+func TestAccProviderRetry(t *testing.T) {
+	t.Parallel()
+
+	// dedicated server to inject failures without affecting other tests
+	server := newFakeSonarr(fakeSonarrAPIKey, "")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Non idempotent request not retried
+			{
+				PreConfig:   func() { server.failNext(http.MethodPost, "tag", http.StatusServiceUnavailable, 1) },
+				Config:      testAccProviderRetryConfig(server.URL(), 3) + testAccTagResourceConfig("test", "retry"),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Throttled request retried
+			{
+				PreConfig: func() { server.failNext(http.MethodPost, "tag", http.StatusTooManyRequests, 1) },
+				Config:    testAccProviderRetryConfig(server.URL(), 3) + testAccTagResourceConfig("test", "retry"),
+				Check:     resource.TestCheckResourceAttr("sonarr_tag.test", "label", "retry"),
+			},
+			// Idempotent request retried
+			{
+				PreConfig: func() { server.failNext(http.MethodGet, "tag", http.StatusServiceUnavailable, 2) },
+				Config:    testAccProviderRetryConfig(server.URL(), 3) + testAccTagResourceConfig("test", "retry"),
+				Check:     resource.TestCheckResourceAttr("sonarr_tag.test", "label", "retry"),
+			},
+			// Retries exhausted
+			{
+				PreConfig:   func() { server.failNext(http.MethodGet, "tag", http.StatusBadGateway, 2) },
+				Config:      testAccProviderRetryConfig(server.URL(), 1) + testAccTagResourceConfig("test", "retry"),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+		},
+	})
+}
+
+func TestAccProviderVersionProbe(t *testing.T) {
+	t.Parallel()
+
+	// dedicated server whose status endpoint is always unavailable
+	server := newFakeSonarr(fakeSonarrAPIKey, "")
+	defer server.Close()
+
+	server.failNext(http.MethodGet, "system/status", http.StatusServiceUnavailable, 1000)
+
+	start := time.Now()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Version detection is not retried
+			{
+				Config: testAccProviderVersionProbeConfig(server.URL()) + testAccTagResourceConfig("test", "probe"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_tag.test", "label", "probe"),
+					func(_ *terraform.State) error {
+						if elapsed := time.Since(start); elapsed > 20*time.Second {
+							return fmt.Errorf("version detection was retried, apply took %s", elapsed)
+						}
+
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccProviderVersionProbeConfig(url string) string {
+	return fmt.Sprintf(`
+	provider "sonarr" {
+		url = "%s"
+		api_key = "%s"
+		max_retries = 3
+		retry_wait_min = 30
+		retry_wait_max = 30
+	}
+	`, url, fakeSonarrAPIKey)
+}
+
+func testAccProviderRetryConfig(url string, retries int) string {
+	return fmt.Sprintf(`
+	provider "sonarr" {
+		url = "%s"
+		api_key = "%s"
+		max_retries = %d
+		retry_wait_min = 0
+	}
+	`, url, fakeSonarrAPIKey, retries)
+}
+
+func TestAccProviderMaxConcurrentWrites(t *testing.T) {
+	t.Parallel()
+
+	// dedicated server rejecting concurrent writes like the Sonarr SQLite database
+	server := newFakeSonarr(fakeSonarrAPIKey, "")
+	server.writeDelay = 20 * time.Millisecond
+
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Writes not limited by default
+			{
+				Config:      testAccProviderMaxConcurrentWritesConfig(server.URL(), ""),
+				ExpectError: regexp.MustCompile("database is locked"),
+			},
+			// Concurrent writes
+			{
+				Config:      testAccProviderMaxConcurrentWritesConfig(server.URL(), "max_concurrent_writes = 0"),
+				ExpectError: regexp.MustCompile("database is locked"),
+			},
+			// Serialized writes
+			{
+				Config: testAccProviderMaxConcurrentWritesConfig(server.URL(), "max_concurrent_writes = 1"),
+				Check:  resource.TestCheckResourceAttr("sonarr_tag.test.9", "label", "write-9"),
+			},
+		},
+	})
+}
+
+func testAccProviderMaxConcurrentWritesConfig(url, writes string) string {
+	return fmt.Sprintf(`
+	provider "sonarr" {
+		url = "%s"
+		api_key = "%s"
+		max_retries = 0
+		%s
+	}
+
+	resource "sonarr_tag" "test" {
+		count = 10
+		label = "write-${count.index}"
+	}
+	`, url, fakeSonarrAPIKey, writes)
+}
+
+func TestAccProviderTLS(t *testing.T) {
+	t.Parallel()
+
+	clientCertificate, clientKey, clientCAs := testAccClientCertificate(t)
+
+	// dedicated server requiring mutual TLS
+	server := newFakeSonarrTLS(fakeSonarrAPIKey, "", clientCAs)
+	defer server.Close()
+
+	caFile := filepath.Join(t.TempDir(), "ca.pem")
+	if err := os.WriteFile(caFile, []byte(server.certificatePEM()), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing client certificate
+			{
+				Config:      testAccProviderTLSConfig(server.URL(), fmt.Sprintf("ca_certificate = <<EOT\n%sEOT", server.certificatePEM()), "", "") + testAccTagResourceConfig("test", "tls"),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Custom CA
+			{
+				Config: testAccProviderTLSConfig(server.URL(), fmt.Sprintf("ca_certificate = <<EOT\n%sEOT", server.certificatePEM()), clientCertificate, clientKey) + testAccTagResourceConfig("test", "tls"),
+				Check:  resource.TestCheckResourceAttr("sonarr_tag.test", "label", "tls"),
+			},
+			// Unknown CA
+			{
+				Config:      testAccProviderTLSConfig(server.URL(), "", clientCertificate, clientKey) + testAccTagResourceConfig("test", "tls"),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Insecure
+			{
+				Config: testAccProviderTLSConfig(server.URL(), "insecure_skip_verify = true", clientCertificate, clientKey) + testAccTagResourceConfig("test", "tls"),
+				Check:  resource.TestCheckResourceAttr("sonarr_tag.test", "label", "tls"),
+			},
+			// Custom CA file
+			{
+				Config: testAccProviderTLSConfig(server.URL(), fmt.Sprintf("ca_certificate_file = %q", caFile), clientCertificate, clientKey) + testAccTagResourceConfig("test", "tls-file"),
+				Check:  resource.TestCheckResourceAttr("sonarr_tag.test", "label", "tls-file"),
+			},
+		},
+	})
+}
+
+func TestAccProviderURLBase(t *testing.T) {
+	t.Parallel()
+
+	// dedicated server behind a reverse proxy sub-path
+	server := newFakeSonarr(fakeSonarrAPIKey, "/sonarr")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Missing URL base
+			{
+				Config:      testAccProviderURLConfig(server.Server.URL) + testAccTagResourceConfig("test", "base"),
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// URL base
+			{
+				Config: testAccProviderURLConfig(server.URL()) + testAccTagResourceConfig("test", "base"),
+				Check:  resource.TestCheckResourceAttr("sonarr_tag.test", "label", "base"),
+			},
+			// URL base with trailing slash
+			{
+				Config: testAccProviderURLConfig(server.URL()+"/") + testAccTagResourceConfig("test", "slash"),
+				Check:  resource.TestCheckResourceAttr("sonarr_tag.test", "label", "slash"),
+			},
+		},
+	})
+}
+
+func TestAccProviderWaitForReady(t *testing.T) {
+	t.Parallel()
+
+	// dedicated server to simulate a starting Sonarr
+	server := newFakeSonarr(fakeSonarrAPIKey, "")
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid API key
+			{
+				Config:      testAccProviderWaitForReadyConfig(server.URL(), "ErrorAPIKey", 60) + testAccSystemStatusDataSourceConfig,
+				ExpectError: regexp.MustCompile("invalid API key"),
+			},
+			// Wait until ready
+			{
+				PreConfig: func() { server.failNext(http.MethodGet, "system/status", http.StatusServiceUnavailable, 2) },
+				Config:    testAccProviderWaitForReadyConfig(server.URL(), fakeSonarrAPIKey, 60) + testAccSystemStatusDataSourceConfig,
+				Check:     resource.TestCheckResourceAttrSet("data.sonarr_system_status.test", "id"),
+			},
+		},
+	})
+}
+
+func TestAccProviderWaitForReadyTimeout(t *testing.T) {
+	t.Parallel()
+
+	// dedicated server never getting ready
+	server := newFakeSonarr(fakeSonarrAPIKey, "")
+	defer server.Close()
+
+	server.failNext(http.MethodGet, "system/status", http.StatusServiceUnavailable, 1000)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccProviderWaitForReadyConfig(server.URL(), fakeSonarrAPIKey, 2) + testAccSystemStatusDataSourceConfig,
+				ExpectError: regexp.MustCompile("Sonarr not ready"),
+			},
+		},
+	})
+}
+
+func testAccProviderWaitForReadyConfig(url, key string, timeout int) string {
+	return fmt.Sprintf(`
+	provider "sonarr" {
+		url = "%s"
+		api_key = "%s"
+		max_retries = 0
+		wait_for_ready = {
+			timeout = %d
+			poll_interval = 1
+		}
+	}
+	`, url, key, timeout)
+}
+
+func TestAccProviderConfigFiles(t *testing.T) {
+	t.Parallel()
+
+	// dedicated server with a different API key and URL base
+	server := newFakeSonarr("config-xml-api-key", "/sonarr")
+	defer server.Close()
+
+	dir := t.TempDir()
+	keyFile := filepath.Join(dir, "api_key")
+	configFile := filepath.Join(dir, "config.xml")
+	invalidFile := filepath.Join(dir, "invalid.xml")
+
+	files := map[string]string{
+		keyFile:     "config-xml-api-key\n",
+		configFile:  "<Config><Port>8989</Port><EnableSsl>False</EnableSsl><ApiKey>config-xml-api-key</ApiKey><UrlBase>/sonarr</UrlBase></Config>",
+		invalidFile: "<Config><ApiKey>config-xml-api-key</ApiKey>",
+	}
+	for name, content := range files {
+		if err := os.WriteFile(name, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Malformed config.xml
+			{
+				Config:      testAccProviderConfigFilesConfig(server.Server.URL, "config_xml_path", invalidFile) + testAccSystemStatusDataSourceConfig,
+				ExpectError: regexp.MustCompile("Unable to read Sonarr config.xml"),
+			},
+			// Key and URL base from config.xml
+			{
+				Config: testAccProviderConfigFilesConfig(server.Server.URL, "config_xml_path", configFile) + testAccSystemStatusDataSourceConfig,
+				Check:  resource.TestCheckResourceAttrSet("data.sonarr_system_status.test", "id"),
+			},
+			// Key from file
+			{
+				Config: testAccProviderConfigFilesConfig(server.URL(), "api_key_file", keyFile) + testAccSystemStatusDataSourceConfig,
+				Check:  resource.TestCheckResourceAttrSet("data.sonarr_system_status.test", "id"),
+			},
+		},
+	})
+}
+
+func testAccProviderConfigFilesConfig(url, attribute, file string) string {
+	return fmt.Sprintf(`
+	provider "sonarr" {
+		url = "%s"
+		%s = "%s"
+		max_retries = 0
+	}
+	`, url, attribute, file)
+}
+
+func TestAccProviderVersionRequirements(t *testing.T) {
+	t.Parallel()
+
+	// dedicated server running Sonarr v3
+	server := newFakeSonarr(fakeSonarrAPIKey, "")
+	server.status["version"] = "3.0.10.1567"
+
+	defer server.Close()
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unsupported resource
+			{
+				Config:      testAccProviderURLConfig(server.URL()) + testAccTagResourceConfig("test", "version") + testAccAutoTagResourceConfig("version", "false"),
+				ExpectError: regexp.MustCompile(`sonarr_auto_tag requires Sonarr >= 4.0.0,\s+got: 3.0.10.1567`),
+			},
+			// Unsupported data source
+			{
+				Config:      testAccProviderURLConfig(server.URL()) + `data "sonarr_auto_tags" "test" {}`,
+				ExpectError: regexp.MustCompile(`sonarr_auto_tags requires Sonarr\s+>= 4.0.0`),
+			},
+			// Unsupported attribute
+			{
+				Config:      testAccProviderURLConfig(server.URL()) + testAccProviderVersionNotificationConfig("true"),
+				ExpectError: regexp.MustCompile(`(?s)on_manual_interaction_required requires\s+Sonarr >= 4.0.0`),
+			},
+			// Supported attribute value
+			{
+				Config: testAccProviderURLConfig(server.URL()) + testAccProviderVersionNotificationConfig("false"),
+				Check:  resource.TestCheckResourceAttrSet("sonarr_notification_webhook.test", "id"),
+			},
+		},
+	})
+}
+
+func testAccProviderVersionNotificationConfig(manual string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_notification_webhook" "test" {
+		on_grab                        = true
+		on_manual_interaction_required = %s
+
+		include_health_warnings = false
+		name                    = "version"
+
+		url = "http://webhook:9091"
+		method = 1
+	}`, manual)
+}
+
+func testAccProviderURLConfig(url string) string {
+	return fmt.Sprintf(`
+	provider "sonarr" {
+		url = "%s"
+		api_key = "%s"
+		max_retries = 0
+	}
+	`, url, fakeSonarrAPIKey)
+}
+
+func testAccProviderTLSConfig(url, trust, certificate, key string) string {
+	client := ""
+	if certificate != "" {
+		client = fmt.Sprintf("client_certificate = <<EOT\n%sEOT\nclient_key = <<EOT\n%sEOT", certificate, key)
+	}
+
+	return fmt.Sprintf(`
+provider "sonarr" {
+	url = "%s"
+	api_key = "%s"
+	max_retries = 0
+%s
+%s
+}
+`, url, fakeSonarrAPIKey, trust, client)
+}
+
+// testAccClientCertificate generates a self-signed client certificate, its key and the pool trusting it.
+func testAccClientCertificate(t *testing.T) (string, string, *x509.CertPool) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	certificate, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pool := x509.NewCertPool()
+	pool.AddCert(certificate)
+
+	return string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})),
+		string(pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer})),
+		pool
+}
+
 func testAccPreCheck(t *testing.T) {
 	t.Helper()
 
@@ -37,9 +523,44 @@ func testAccAPIClient() *sonarr.APIClient {
 	return sonarr.NewAPIClient(config)
 }
 
-const testUnauthorizedProvider = `
+// testAccCheckResourceDisappears deletes the resource directly from Sonarr,
+// so that the following refresh must remove it from state.
+func testAccCheckResourceDisappears(name, endpoint string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", name)
+		}
+
+		url := fmt.Sprintf("%s/api/v3/%s/%s", os.Getenv("SONARR_URL"), endpoint, rs.Primary.ID)
+
+		req, err := http.NewRequestWithContext(context.Background(), http.MethodDelete, url, nil)
+		if err != nil {
+			return err
+		}
+
+		req.Header.Set("X-Api-Key", os.Getenv("SONARR_API_KEY"))
+
+		resp, err := http.DefaultClient.Do(req)
+		if err != nil {
+			return err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("unable to delete %s: got status %d", name, resp.StatusCode)
+		}
+
+		return nil
+	}
+}
+
+// testUnauthorizedProvider is set in TestMain to target the Sonarr used by acceptance tests.
+var testUnauthorizedProvider string
+
+const testUnauthorizedProviderConfig = `
 provider "sonarr" {
-	url = "http://localhost:8989"
+	url = "%s"
 	api_key = "ErrorAPIKey"
 	extra_headers = [
 		{
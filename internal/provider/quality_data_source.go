@@ -36,6 +36,57 @@ type Quality struct {
 	Resolution types.Int64  `tfsdk:"resolution"`
 }
 
+// defaultQualities is the static table of the qualities built into Sonarr v4, sorted by weight.
+// Their IDs are the same on every instance, TestDefaultQualities pins them to the Sonarr definitions.
+var defaultQualities = []struct {
+	name       string
+	source     sonarr.QualitySource
+	id         int32
+	resolution int32
+}{
+	{"Unknown", sonarr.QUALITYSOURCE_UNKNOWN, 0, 0},
+	{"SDTV", sonarr.QUALITYSOURCE_TELEVISION, 1, 480},
+	{"WEBRip-480p", sonarr.QUALITYSOURCE_WEB_RIP, 12, 480},
+	{"WEBDL-480p", sonarr.QUALITYSOURCE_WEB, 8, 480},
+	{"DVD", sonarr.QUALITYSOURCE_DVD, 2, 480},
+	{"Bluray-480p", sonarr.QUALITYSOURCE_BLURAY, 13, 480},
+	{"Bluray-576p", sonarr.QUALITYSOURCE_BLURAY, 22, 576},
+	{"HDTV-720p", sonarr.QUALITYSOURCE_TELEVISION, 4, 720},
+	{"HDTV-1080p", sonarr.QUALITYSOURCE_TELEVISION, 9, 1080},
+	{"Raw-HD", sonarr.QUALITYSOURCE_TELEVISION_RAW, 10, 1080},
+	{"WEBRip-720p", sonarr.QUALITYSOURCE_WEB_RIP, 14, 720},
+	{"WEBDL-720p", sonarr.QUALITYSOURCE_WEB, 5, 720},
+	{"Bluray-720p", sonarr.QUALITYSOURCE_BLURAY, 6, 720},
+	{"WEBRip-1080p", sonarr.QUALITYSOURCE_WEB_RIP, 15, 1080},
+	{"WEBDL-1080p", sonarr.QUALITYSOURCE_WEB, 3, 1080},
+	{"Bluray-1080p", sonarr.QUALITYSOURCE_BLURAY, 7, 1080},
+	{"Bluray-1080p Remux", sonarr.QUALITYSOURCE_BLURAY_RAW, 20, 1080},
+	{"HDTV-2160p", sonarr.QUALITYSOURCE_TELEVISION, 16, 2160},
+	{"WEBRip-2160p", sonarr.QUALITYSOURCE_WEB_RIP, 17, 2160},
+	{"WEBDL-2160p", sonarr.QUALITYSOURCE_WEB, 18, 2160},
+	{"Bluray-2160p", sonarr.QUALITYSOURCE_BLURAY, 19, 2160},
+	{"Bluray-2160p Remux", sonarr.QUALITYSOURCE_BLURAY_RAW, 21, 2160},
+}
+
+// defaultQualityDefinitions returns the built-in qualities in the API format.
+func defaultQualityDefinitions() []sonarr.QualityDefinitionResource {
+	definitions := make([]sonarr.QualityDefinitionResource, len(defaultQualities))
+
+	for i, q := range defaultQualities {
+		quality := sonarr.NewQuality()
+		quality.SetId(q.id)
+		quality.SetName(q.name)
+		quality.SetSource(q.source)
+		quality.SetResolution(q.resolution)
+
+		definitions[i].SetQuality(*quality)
+		definitions[i].SetTitle(q.name)
+		definitions[i].SetWeight(int32(i + 1))
+	}
+
+	return definitions
+}
+
 func (q Quality) getType() attr.Type {
 	return types.ObjectType{}.WithAttributeTypes(
 		map[string]attr.Type{
@@ -91,7 +142,7 @@ func (d *QualityDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 	// Get qualitys current value
-	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(d.auth).Execute()
+	response, _, err := d.client.QualityDefinitionAPI.ListQualityDefinition(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDataSourceName, err))
 
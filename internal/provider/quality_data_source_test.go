@@ -5,7 +5,9 @@ import (
 	"regexp"
 	"testing"
 
+	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccQualityDataSource(t *testing.T) {
@@ -43,3 +45,44 @@ func testAccQualityDataSourceConfig(name string) string {
 	}
 	`, name)
 }
+
+// TestDefaultQualities pins the built-in qualities to the Sonarr v4 definitions
+// (src/NzbDrone.Core/Qualities/Quality.cs), in their default weight order.
+func TestDefaultQualities(t *testing.T) {
+	t.Parallel()
+
+	expected := []string{
+		"0 Unknown unknown 0",
+		"1 SDTV television 480",
+		"12 WEBRip-480p webRip 480",
+		"8 WEBDL-480p web 480",
+		"2 DVD dvd 480",
+		"13 Bluray-480p bluray 480",
+		"22 Bluray-576p bluray 576",
+		"4 HDTV-720p television 720",
+		"9 HDTV-1080p television 1080",
+		"10 Raw-HD televisionRaw 1080",
+		"14 WEBRip-720p webRip 720",
+		"5 WEBDL-720p web 720",
+		"6 Bluray-720p bluray 720",
+		"15 WEBRip-1080p webRip 1080",
+		"3 WEBDL-1080p web 1080",
+		"7 Bluray-1080p bluray 1080",
+		"20 Bluray-1080p Remux blurayRaw 1080",
+		"16 HDTV-2160p television 2160",
+		"17 WEBRip-2160p webRip 2160",
+		"18 WEBDL-2160p web 2160",
+		"19 Bluray-2160p bluray 2160",
+		"21 Bluray-2160p Remux blurayRaw 2160",
+	}
+
+	qualities := make([]string, len(defaultQualities))
+	for i, quality := range defaultQualities {
+		qualities[i] = fmt.Sprintf("%d %s %s %d", quality.id, quality.name, quality.source, quality.resolution)
+
+		_, err := sonarr.NewQualitySourceFromValue(string(quality.source))
+		assert.Nil(t, err)
+	}
+
+	assert.Equal(t, expected, qualities)
+}
@@ -134,9 +134,9 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	request := definition.read()
 
 	// Read to get the quality ID
-	read, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, request.GetId()).Execute()
+	read, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(helpers.WithAuth(ctx, r.auth), request.GetId()).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, qualityDefinitionResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -145,9 +145,9 @@ func (r *QualityDefinitionResource) Create(ctx context.Context, req resource.Cre
 	request.Quality.SetSource(read.Quality.GetSource())
 
 	// Create new QualityDefinition
-	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
+	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, qualityDefinitionResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, qualityDefinitionResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -169,9 +169,9 @@ func (r *QualityDefinitionResource) Read(ctx context.Context, req resource.ReadR
 	}
 
 	// Get qualitydefinition current value
-	response, _, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(r.auth, int32(definition.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.QualityDefinitionAPI.GetQualityDefinitionById(helpers.WithAuth(ctx, r.auth), int32(definition.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, qualityDefinitionResourceName, err))
+		helpers.HandleReadError(ctx, qualityDefinitionResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -196,9 +196,9 @@ func (r *QualityDefinitionResource) Update(ctx context.Context, req resource.Upd
 	request := definition.read()
 
 	// Update QualityDefinition
-	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(r.auth, strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
+	response, _, err := r.client.QualityDefinitionAPI.UpdateQualityDefinition(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).QualityDefinitionResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, qualityDefinitionResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, qualityDefinitionResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
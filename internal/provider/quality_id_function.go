@@ -0,0 +1,62 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+const qualityIDFunctionName = "quality_id"
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &QualityIDFunction{}
+
+func NewQualityIDFunction() function.Function {
+	return &QualityIDFunction{}
+}
+
+// QualityIDFunction defines the quality ID function implementation.
+type QualityIDFunction struct{}
+
+func (f *QualityIDFunction) Metadata(_ context.Context, _ function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = qualityIDFunctionName
+}
+
+func (f *QualityIDFunction) Definition(_ context.Context, _ function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary:             "Quality ID from name.",
+		MarkdownDescription: "Returns the ID of a quality built into Sonarr (e.g. `HDTV-1080p`). The ID comes from a static table of the Sonarr v4 built-in qualities, without calling Sonarr. Refer to the `sonarr_quality` data source for the available names.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "Quality name.",
+			},
+		},
+		Return: function.Int64Return{},
+	}
+}
+
+func (f *QualityIDFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = req.Arguments.Get(ctx, &name)
+	if resp.Error != nil {
+		return
+	}
+
+	var (
+		quality Quality
+		diags   diag.Diagnostics
+	)
+
+	quality.find(name, defaultQualityDefinitions(), &diags)
+
+	if diags.HasError() {
+		resp.Error = function.NewArgumentFuncError(0, diags[0].Detail())
+
+		return
+	}
+
+	resp.Error = resp.Result.Set(ctx, quality.ID)
+}
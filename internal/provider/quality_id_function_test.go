@@ -0,0 +1,42 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccQualityIDFunction(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Not found testing
+			{
+				Config:      testAccQualityIDFunctionConfig("Error"),
+				ExpectError: regexp.MustCompile("Unable to find quality"),
+			},
+			// Read testing
+			{
+				Config: testAccQualityIDFunctionConfig("HDTV-1080p"),
+				Check:  resource.TestCheckOutput("test", "9"),
+			},
+		},
+	})
+}
+
+func testAccQualityIDFunctionConfig(name string) string {
+	return fmt.Sprintf(`
+	output "test" {
+		value = provider::sonarr::quality_id("%s")
+	}
+	`, name)
+}
@@ -46,6 +46,19 @@ func TestAccQualityProfileResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccQualityProfileResourceConfig("example-HD"),
+				Check:              testAccCheckResourceDisappears("sonarr_quality_profile.test", "qualityprofile"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccQualityProfileResourceConfig("example-HD"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_quality_profile.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
@@ -24,8 +25,9 @@ func NewReleaseProfileDataSource() datasource.DataSource {
 
 // ReleaseProfileDataSource defines the release profile implementation.
 type ReleaseProfileDataSource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	version *version.Version
 }
 
 func (d *ReleaseProfileDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -73,13 +75,20 @@ func (d *ReleaseProfileDataSource) Schema(_ context.Context, _ datasource.Schema
 }
 
 func (d *ReleaseProfileDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
+	if providerData := dataSourceConfigureData(ctx, req, resp); providerData != nil {
+		d.client = providerData.Client
+		d.auth = providerData.Auth
+		d.version = providerData.Version
 	}
 }
 
 func (d *ReleaseProfileDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	helpers.CheckVersionRequirements(ctx, "sonarr_"+releaseProfileDataSourceName, d.version, req.Config, releaseProfileVersionRequirements, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	var data *ReleaseProfile
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -88,7 +97,7 @@ func (d *ReleaseProfileDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 	// Get releaseprofiles current value
-	response, _, err := d.client.ReleaseProfileAPI.ListReleaseProfile(d.auth).Execute()
+	response, _, err := d.client.ReleaseProfileAPI.ListReleaseProfile(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseProfileDataSourceName, err))
 
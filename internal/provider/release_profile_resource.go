@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
@@ -21,10 +22,16 @@ import (
 
 const releaseProfileResourceName = "release_profile"
 
+// releaseProfileVersionRequirements declares the Sonarr version introducing the current release profile shape.
+var releaseProfileVersionRequirements = []helpers.VersionRequirement{
+	helpers.NewVersionRequirement("4.0.0"),
+}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &ReleaseProfileResource{}
 	_ resource.ResourceWithImportState = &ReleaseProfileResource{}
+	_ resource.ResourceWithModifyPlan  = &ReleaseProfileResource{}
 )
 
 func NewReleaseProfileResource() resource.Resource {
@@ -33,8 +40,9 @@ func NewReleaseProfileResource() resource.Resource {
 
 // ReleaseProfileResource defines the release profile implementation.
 type ReleaseProfileResource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	version *version.Version
 }
 
 // ReleaseProfile describes the release profile data model.
@@ -117,12 +125,17 @@ func (r *ReleaseProfileResource) Schema(_ context.Context, _ resource.SchemaRequ
 }
 
 func (r *ReleaseProfileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
-	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
-		r.client = client
-		r.auth = auth
+	if providerData := resourceConfigureData(ctx, req, resp); providerData != nil {
+		r.client = providerData.Client
+		r.auth = providerData.Auth
+		r.version = providerData.Version
 	}
 }
 
+func (r *ReleaseProfileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	helpers.CheckVersionRequirements(ctx, "sonarr_"+releaseProfileResourceName, r.version, req.Config, releaseProfileVersionRequirements, &resp.Diagnostics)
+}
+
 func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
 	var profile *ReleaseProfile
@@ -137,9 +150,9 @@ func (r *ReleaseProfileResource) Create(ctx context.Context, req resource.Create
 	request := profile.read(ctx, &resp.Diagnostics)
 
 	// Create new ReleaseProfile
-	response, _, err := r.client.ReleaseProfileAPI.CreateReleaseProfile(r.auth).ReleaseProfileResource(*request).Execute()
+	response, _, err := r.client.ReleaseProfileAPI.CreateReleaseProfile(helpers.WithAuth(ctx, r.auth)).ReleaseProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, releaseProfileResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, releaseProfileResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -161,9 +174,9 @@ func (r *ReleaseProfileResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Get releaseprofile current value
-	response, _, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(r.auth, int32(profile.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.ReleaseProfileAPI.GetReleaseProfileById(helpers.WithAuth(ctx, r.auth), int32(profile.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseProfileResourceName, err))
+		helpers.HandleReadError(ctx, releaseProfileResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -188,9 +201,9 @@ func (r *ReleaseProfileResource) Update(ctx context.Context, req resource.Update
 	request := profile.read(ctx, &resp.Diagnostics)
 
 	// Update ReleaseProfile
-	response, _, err := r.client.ReleaseProfileAPI.UpdateReleaseProfile(r.auth, strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
+	response, _, err := r.client.ReleaseProfileAPI.UpdateReleaseProfile(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(request.GetId()))).ReleaseProfileResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, releaseProfileResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, releaseProfileResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -211,7 +224,7 @@ func (r *ReleaseProfileResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Delete releaseprofile current value
-	_, err := r.client.ReleaseProfileAPI.DeleteReleaseProfile(r.auth, int32(ID)).Execute()
+	_, err := r.client.ReleaseProfileAPI.DeleteReleaseProfile(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, releaseProfileResourceName, err))
 
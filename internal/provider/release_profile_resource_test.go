@@ -46,6 +46,19 @@ func TestAccReleaseProfileResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccReleaseProfileResourceConfig("resourceTest", "test2"),
+				Check:              testAccCheckResourceDisappears("sonarr_release_profile.test", "releaseprofile"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccReleaseProfileResourceConfig("resourceTest", "test2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_release_profile.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -6,6 +6,7 @@ import (
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/go-version"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -23,8 +24,9 @@ func NewReleaseProfilesDataSource() datasource.DataSource {
 
 // ReleaseProfilesDataSource defines the release profiles implementation.
 type ReleaseProfilesDataSource struct {
-	client *sonarr.APIClient
-	auth   context.Context
+	client  *sonarr.APIClient
+	auth    context.Context
+	version *version.Version
 }
 
 // ReleaseProfiles describes the release profiles data model.
@@ -90,15 +92,22 @@ func (d *ReleaseProfilesDataSource) Schema(_ context.Context, _ datasource.Schem
 }
 
 func (d *ReleaseProfilesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	if auth, client := dataSourceConfigure(ctx, req, resp); client != nil {
-		d.client = client
-		d.auth = auth
+	if providerData := dataSourceConfigureData(ctx, req, resp); providerData != nil {
+		d.client = providerData.Client
+		d.auth = providerData.Auth
+		d.version = providerData.Version
 	}
 }
 
-func (d *ReleaseProfilesDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
+func (d *ReleaseProfilesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	helpers.CheckVersionRequirements(ctx, "sonarr_"+releaseProfilesDataSourceName, d.version, req.Config, releaseProfileVersionRequirements, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	// Get releaseprofiles current value
-	response, _, err := d.client.ReleaseProfileAPI.ListReleaseProfile(d.auth).Execute()
+	response, _, err := d.client.ReleaseProfileAPI.ListReleaseProfile(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, releaseProfileResourceName, err))
 
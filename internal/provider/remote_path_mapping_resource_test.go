@@ -46,6 +46,19 @@ func TestAccRemotePathMappingResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccRemotePathMappingResourceConfig("remotemapResourceTest", "/test2/"),
+				Check:              testAccCheckResourceDisappears("sonarr_remote_path_mapping.test", "remotepathmapping"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccRemotePathMappingResourceConfig("remotemapResourceTest", "/test2/"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_remote_path_mapping.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
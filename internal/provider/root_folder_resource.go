@@ -142,9 +142,9 @@ func (r *RootFolderResource) Create(ctx context.Context, req resource.CreateRequ
 	request := *sonarr.NewRootFolderResource()
 	request.SetPath(folder.Path.ValueString())
 
-	response, _, err := r.client.RootFolderAPI.CreateRootFolder(r.auth).RootFolderResource(request).Execute()
+	response, _, err := r.client.RootFolderAPI.CreateRootFolder(helpers.WithAuth(ctx, r.auth)).RootFolderResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, rootFolderResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, rootFolderResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -166,9 +166,9 @@ func (r *RootFolderResource) Read(ctx context.Context, req resource.ReadRequest,
 	}
 
 	// Get rootFolder current value
-	response, _, err := r.client.RootFolderAPI.GetRootFolderById(r.auth, int32(folder.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.RootFolderAPI.GetRootFolderById(helpers.WithAuth(ctx, r.auth), int32(folder.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, rootFolderResourceName, err))
+		helpers.HandleReadError(ctx, rootFolderResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -193,7 +193,7 @@ func (r *RootFolderResource) Delete(ctx context.Context, req resource.DeleteRequ
 	}
 
 	// Delete rootFolder current value
-	_, err := r.client.RootFolderAPI.DeleteRootFolder(r.auth, int32(ID)).Execute()
+	_, err := r.client.RootFolderAPI.DeleteRootFolder(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, rootFolderResourceName, err))
 
@@ -46,6 +46,19 @@ func TestAccRootFolderResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccRootFolderResourceConfig("/config/logs"),
+				Check:              testAccCheckResourceDisappears("sonarr_root_folder.test", "rootfolder"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccRootFolderResourceConfig("/config/logs"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_root_folder.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"maps"
 	"strconv"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
@@ -80,8 +81,17 @@ func (d *SearchSeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRe
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"series_type": schema.StringAttribute{
+				MarkdownDescription: "Series type.",
+				Computed:            true,
+			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new items.",
+				Computed:            true,
+			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, SeriesDataSource{}.getMetadataSchema().Attributes)
 }
 
 func (d *SearchSeriesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
@@ -100,7 +110,7 @@ func (d *SearchSeriesDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 	// Get series current value
-	response, _, err := d.client.SeriesLookupAPI.ListSeriesLookup(d.auth).Term(strconv.Itoa(int(data.TvdbID.ValueInt64()))).Execute()
+	response, _, err := d.client.SeriesLookupAPI.ListSeriesLookup(helpers.WithAuth(ctx, d.auth)).Term(strconv.Itoa(int(data.TvdbID.ValueInt64()))).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, searchSearchSeriesDataSourceName, err))
 
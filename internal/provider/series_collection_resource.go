@@ -0,0 +1,676 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/devopsarr/sonarr-go/sonarr"
+	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	seriesCollectionResourceName = "series_collection"
+	bulkMoveSeriesCommand        = "BulkMoveSeries"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ resource.Resource                = &SeriesCollectionResource{}
+	_ resource.ResourceWithImportState = &SeriesCollectionResource{}
+	_ resource.ResourceWithModifyPlan  = &SeriesCollectionResource{}
+)
+
+func NewSeriesCollectionResource() resource.Resource {
+	return &SeriesCollectionResource{}
+}
+
+// SeriesCollectionResource defines the series collection implementation.
+type SeriesCollectionResource struct {
+	client *sonarr.APIClient
+	auth   context.Context
+}
+
+// SeriesCollection describes the series collection data model.
+type SeriesCollection struct {
+	Series                          types.Map    `tfsdk:"series"`
+	ID                              types.String `tfsdk:"id"`
+	MoveFilesOnPathChange           types.Bool   `tfsdk:"move_files_on_path_change"`
+	DeleteFilesOnDestroy            types.Bool   `tfsdk:"delete_files_on_destroy"`
+	AddImportListExclusionOnDestroy types.Bool   `tfsdk:"add_import_list_exclusion_on_destroy"`
+}
+
+// SeriesCollectionItem is part of SeriesCollection.
+type SeriesCollectionItem struct {
+	Tags             types.Set    `tfsdk:"tags"`
+	Title            types.String `tfsdk:"title"`
+	Path             types.String `tfsdk:"path"`
+	RootFolderPath   types.String `tfsdk:"root_folder_path"`
+	SeriesType       types.String `tfsdk:"series_type"`
+	MonitorNewItems  types.String `tfsdk:"monitor_new_items"`
+	ID               types.Int64  `tfsdk:"id"`
+	QualityProfileID types.Int64  `tfsdk:"quality_profile_id"`
+	Monitored        types.Bool   `tfsdk:"monitored"`
+	SeasonFolder     types.Bool   `tfsdk:"season_folder"`
+}
+
+func (s SeriesCollectionItem) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"tags":               types.SetType{}.WithElementType(types.Int64Type),
+			"title":              types.StringType,
+			"path":               types.StringType,
+			"root_folder_path":   types.StringType,
+			"series_type":        types.StringType,
+			"monitor_new_items":  types.StringType,
+			"id":                 types.Int64Type,
+			"quality_profile_id": types.Int64Type,
+			"monitored":          types.BoolType,
+			"season_folder":      types.BoolType,
+		})
+}
+
+func (r *SeriesCollectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_" + seriesCollectionResourceName
+}
+
+func (r *SeriesCollectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "<!-- subcategory:Series -->\nSeries collection resource.\nManages many [Series](../resources/series) through the bulk import and editor endpoints, reading all of them with a single request. " +
+			"Series not in the collection are not managed. A series must not be managed by both this resource and `sonarr_series`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Series collection ID, made of the sorted TVDB IDs of its series separated by commas.",
+				Computed:            true,
+			},
+			"move_files_on_path_change": schema.BoolAttribute{
+				MarkdownDescription: "Move the series files to the new folder when `root_folder_path` changes, waiting for Sonarr to complete the move. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"delete_files_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Delete the series folders and their files when series are removed from the collection or the resource is destroyed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"add_import_list_exclusion_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Add an import list exclusion for the series removed from the collection or when the resource is destroyed. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"series": schema.MapNestedAttribute{
+				MarkdownDescription: "Series settings by TVDB ID.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.KeysAre(stringvalidator.RegexMatches(regexp.MustCompile(`^[1-9]\d*$`), "must be a TVDB ID")),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"root_folder_path": schema.StringAttribute{
+							MarkdownDescription: "Series Root Folder.",
+							Required:            true,
+						},
+						"quality_profile_id": schema.Int64Attribute{
+							MarkdownDescription: "Quality Profile ID.",
+							Required:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"season_folder": schema.BoolAttribute{
+							MarkdownDescription: "Season Folder flag. Defaults to `true`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             booldefault.StaticBool(true),
+						},
+						"series_type": schema.StringAttribute{
+							MarkdownDescription: "Series type. Valid values are 'standard', 'daily' and 'anime'. Defaults to `standard`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(string(sonarr.SERIESTYPES_STANDARD)),
+							Validators: []validator.String{
+								stringvalidator.OneOf("standard", "daily", "anime"),
+							},
+						},
+						"monitor_new_items": schema.StringAttribute{
+							MarkdownDescription: "Monitor new seasons. Valid values are 'all' and 'none'. Defaults to `all`.",
+							Optional:            true,
+							Computed:            true,
+							Default:             stringdefault.StaticString(string(sonarr.NEWITEMMONITORTYPES_ALL)),
+							Validators: []validator.String{
+								stringvalidator.OneOf("all", "none"),
+							},
+						},
+						"tags": schema.SetAttribute{
+							MarkdownDescription: "List of associated tags.",
+							Optional:            true,
+							Computed:            true,
+							ElementType:         types.Int64Type,
+							Default:             setdefault.StaticValue(types.SetValueMust(types.Int64Type, []attr.Value{})),
+						},
+						"id": schema.Int64Attribute{
+							MarkdownDescription: "Series ID.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.Int64{
+								int64planmodifier.UseStateForUnknown(),
+							},
+						},
+						"title": schema.StringAttribute{
+							MarkdownDescription: "Series Title.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+						"path": schema.StringAttribute{
+							MarkdownDescription: "Series Path.",
+							Computed:            true,
+							PlanModifiers: []planmodifier.String{
+								stringplanmodifier.UseStateForUnknown(),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *SeriesCollectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if auth, client := resourceConfigure(ctx, req, resp); client != nil {
+		r.client = client
+		r.auth = auth
+	}
+}
+
+func (r *SeriesCollectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to plan on destroy
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan, state *SeriesCollection
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// the collection is identified by its series
+	unknown := plan.Series.IsUnknown()
+	for _, element := range plan.Series.Elements() {
+		unknown = unknown || element.IsUnknown()
+	}
+
+	if unknown {
+		plan.ID = types.StringUnknown()
+		resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+
+		return
+	}
+
+	items := plan.items(ctx, &resp.Diagnostics)
+	plan.ID = types.StringValue(collectionID(items))
+
+	// only the series moved to another root folder get a new path
+	if state != nil {
+		previous := state.items(ctx, &resp.Diagnostics)
+
+		for tvdbID, item := range items {
+			if old, ok := previous[tvdbID]; ok && !item.RootFolderPath.Equal(old.RootFolderPath) {
+				item.Path = types.StringUnknown()
+				items[tvdbID] = item
+			}
+		}
+
+		var tempDiag diag.Diagnostics
+
+		plan.Series, tempDiag = types.MapValueFrom(ctx, SeriesCollectionItem{}.getType(), items)
+		resp.Diagnostics.Append(tempDiag...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func (r *SeriesCollectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	// Retrieve values from plan
+	var collection *SeriesCollection
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Import and edit the series
+	r.apply(ctx, helpers.Create, collection, nil, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "created "+seriesCollectionResourceName+": "+collection.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *SeriesCollectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Get current state
+	var collection *SeriesCollection
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Get all series with a single request
+	current := r.list(ctx, helpers.Read, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "read "+seriesCollectionResourceName+": "+collection.ID.ValueString())
+	// Map response body to resource schema attribute
+	collection.write(ctx, collection.items(ctx, &resp.Diagnostics), current, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *SeriesCollectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Get plan and state values
+	var collection, state *SeriesCollection
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &collection)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Remove, import and edit the series
+	r.apply(ctx, helpers.Update, collection, state.items(ctx, &resp.Diagnostics), &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "updated "+seriesCollectionResourceName+": "+collection.ID.ValueString())
+	resp.Diagnostics.Append(resp.State.Set(ctx, &collection)...)
+}
+
+func (r *SeriesCollectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var collection *SeriesCollection
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &collection)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Delete all the series of the collection
+	current := r.list(ctx, helpers.Delete, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.remove(ctx, collection, collection.items(ctx, &resp.Diagnostics), nil, current, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Trace(ctx, "deleted "+seriesCollectionResourceName+": "+collection.ID.ValueString())
+	resp.State.RemoveResource(ctx)
+}
+
+func (r *SeriesCollectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	items := make(map[string]SeriesCollectionItem)
+
+	for _, tvdbID := range strings.Split(req.ID, ",") {
+		tvdbID = strings.TrimSpace(tvdbID)
+		if id, err := strconv.Atoi(tvdbID); err != nil || id <= 0 {
+			resp.Diagnostics.AddError(
+				helpers.UnexpectedImportIdentifier,
+				fmt.Sprintf("Expected import identifier with format: TVDB_ID,TVDB_ID,... Got: %s", req.ID),
+			)
+
+			return
+		}
+
+		items[tvdbID] = SeriesCollectionItem{Tags: types.SetNull(types.Int64Type)}
+	}
+
+	series, diags := types.MapValueFrom(ctx, SeriesCollectionItem{}.getType(), items)
+	resp.Diagnostics.Append(diags...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("series"), series)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), collectionID(items))...)
+	tflog.Trace(ctx, "imported "+seriesCollectionResourceName+": "+req.ID)
+}
+
+// apply reconciles Sonarr with the planned collection.
+// The series removed since the previous collection are deleted, the missing ones are imported
+// and the ones with different settings are edited, grouping the series sharing the same settings.
+func (r *SeriesCollectionResource) apply(ctx context.Context, action string, collection *SeriesCollection, previous map[string]SeriesCollectionItem, diags *diag.Diagnostics) {
+	items := collection.items(ctx, diags)
+
+	current := r.list(ctx, action, diags)
+	if diags.HasError() {
+		return
+	}
+
+	r.remove(ctx, collection, previous, items, current, diags)
+	r.importMissing(ctx, action, items, current, diags)
+	r.edit(ctx, action, collection, items, current, diags)
+
+	if diags.HasError() {
+		return
+	}
+
+	// Read back all the series
+	current = r.list(ctx, action, diags)
+	if diags.HasError() {
+		return
+	}
+
+	collection.ID = types.StringValue(collectionID(items))
+	collection.write(ctx, items, current, diags)
+}
+
+// list gets all the series by TVDB ID.
+func (r *SeriesCollectionResource) list(ctx context.Context, action string, diags *diag.Diagnostics) map[string]*sonarr.SeriesResource {
+	response, _, err := r.client.SeriesAPI.ListSeries(helpers.WithAuth(ctx, r.auth)).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesCollectionResourceName, err))
+
+		return nil
+	}
+
+	series := make(map[string]*sonarr.SeriesResource, len(response))
+	for i := range response {
+		series[strconv.Itoa(int(response[i].GetTvdbId()))] = &response[i]
+	}
+
+	return series
+}
+
+// remove deletes the series of the previous items which are not in the kept ones.
+func (r *SeriesCollectionResource) remove(ctx context.Context, collection *SeriesCollection, previous, kept map[string]SeriesCollectionItem, current map[string]*sonarr.SeriesResource, diags *diag.Diagnostics) {
+	ids := make([]int32, 0, len(previous))
+
+	for tvdbID := range previous {
+		if _, ok := kept[tvdbID]; ok {
+			continue
+		}
+
+		if series, ok := current[tvdbID]; ok {
+			ids = append(ids, series.GetId())
+		}
+	}
+
+	if len(ids) == 0 {
+		return
+	}
+
+	slices.Sort(ids)
+
+	editor := sonarr.NewSeriesEditorResource()
+	editor.SetSeriesIds(ids)
+	editor.SetDeleteFiles(collection.DeleteFilesOnDestroy.ValueBool())
+	editor.SetAddImportListExclusion(collection.AddImportListExclusionOnDestroy.ValueBool())
+
+	if _, err := r.client.SeriesEditorAPI.DeleteSeriesEditor(helpers.WithAuth(ctx, r.auth)).SeriesEditorResource(*editor).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, seriesCollectionResourceName, err))
+	}
+}
+
+// importMissing adds with a single request the series not in Sonarr yet.
+func (r *SeriesCollectionResource) importMissing(ctx context.Context, action string, items map[string]SeriesCollectionItem, current map[string]*sonarr.SeriesResource, diags *diag.Diagnostics) {
+	missing := make([]sonarr.SeriesResource, 0, len(items))
+
+	for _, tvdbID := range sortedKeys(items) {
+		if _, ok := current[tvdbID]; ok {
+			continue
+		}
+
+		item := items[tvdbID]
+		missing = append(missing, *item.read(ctx, tvdbID, diags))
+	}
+
+	if len(missing) == 0 || diags.HasError() {
+		return
+	}
+
+	if _, err := r.client.SeriesImportAPI.CreateSeriesImport(helpers.WithAuth(ctx, r.auth)).SeriesResource(missing).Execute(); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesCollectionResourceName, err))
+	}
+}
+
+// edit updates the series with different settings, with a request for each group of series sharing the same settings.
+func (r *SeriesCollectionResource) edit(ctx context.Context, action string, collection *SeriesCollection, items map[string]SeriesCollectionItem, current map[string]*sonarr.SeriesResource, diags *diag.Diagnostics) {
+	editors := make(map[string]*sonarr.SeriesEditorResource)
+	keys := make([]string, 0)
+	moveFiles := false
+
+	for _, tvdbID := range sortedKeys(items) {
+		series, ok := current[tvdbID]
+		if !ok {
+			continue
+		}
+
+		item := items[tvdbID]
+		if item.matches(ctx, series, diags) {
+			continue
+		}
+
+		moveFiles = moveFiles || (collection.MoveFilesOnPathChange.ValueBool() && series.GetRootFolderPath() != item.RootFolderPath.ValueString())
+
+		editor := item.editor(ctx, diags)
+		key := fmt.Sprintf("%d|%t|%t|%s|%s|%s|%v", editor.GetQualityProfileId(), editor.GetMonitored(), editor.GetSeasonFolder(),
+			editor.GetSeriesType(), editor.GetMonitorNewItems(), editor.GetRootFolderPath(), editor.GetTags())
+
+		if _, ok := editors[key]; !ok {
+			editors[key] = editor
+			keys = append(keys, key)
+		}
+
+		editors[key].SeriesIds = append(editors[key].SeriesIds, series.GetId())
+	}
+
+	if len(keys) == 0 || diags.HasError() {
+		return
+	}
+
+	// Sonarr moves the files in background, through a command queued by the edit
+	var (
+		lastMove int32
+		err      error
+	)
+
+	if moveFiles {
+		lastMove, err = helpers.LastCommandID(helpers.WithAuth(ctx, r.auth), r.client, bulkMoveSeriesCommand)
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesCollectionResourceName, err))
+
+			return
+		}
+	}
+
+	for _, key := range keys {
+		editors[key].SetMoveFiles(collection.MoveFilesOnPathChange.ValueBool())
+
+		if _, err := r.client.SeriesEditorAPI.PutSeriesEditor(helpers.WithAuth(ctx, r.auth)).SeriesEditorResource(*editors[key]).Execute(); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesCollectionResourceName, err))
+
+			return
+		}
+	}
+
+	if moveFiles {
+		if err := helpers.WaitForCommands(helpers.WithAuth(ctx, r.auth), r.client, bulkMoveSeriesCommand, lastMove); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesCollectionResourceName, err))
+		}
+	}
+}
+
+// items returns the collection series by TVDB ID.
+func (s *SeriesCollection) items(ctx context.Context, diags *diag.Diagnostics) map[string]SeriesCollectionItem {
+	items := make(map[string]SeriesCollectionItem, len(s.Series.Elements()))
+	diags.Append(s.Series.ElementsAs(ctx, &items, true)...)
+
+	return items
+}
+
+// write stores the given items as found in Sonarr, dropping the ones not found.
+func (s *SeriesCollection) write(ctx context.Context, items map[string]SeriesCollectionItem, current map[string]*sonarr.SeriesResource, diags *diag.Diagnostics) {
+	found := make(map[string]SeriesCollectionItem, len(items))
+
+	for tvdbID := range items {
+		series, ok := current[tvdbID]
+		if !ok {
+			continue
+		}
+
+		item := SeriesCollectionItem{}
+		item.write(ctx, series, diags)
+		found[tvdbID] = item
+	}
+
+	var tempDiag diag.Diagnostics
+
+	s.Series, tempDiag = types.MapValueFrom(ctx, SeriesCollectionItem{}.getType(), found)
+	diags.Append(tempDiag...)
+
+	// management options are not stored in Sonarr, imported collections get the defaults
+	if s.MoveFilesOnPathChange.IsNull() {
+		s.MoveFilesOnPathChange = types.BoolValue(true)
+	}
+
+	if s.DeleteFilesOnDestroy.IsNull() {
+		s.DeleteFilesOnDestroy = types.BoolValue(false)
+	}
+
+	if s.AddImportListExclusionOnDestroy.IsNull() {
+		s.AddImportListExclusionOnDestroy = types.BoolValue(false)
+	}
+}
+
+func (s *SeriesCollectionItem) write(ctx context.Context, series *sonarr.SeriesResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	s.ID = types.Int64Value(int64(series.GetId()))
+	s.Title = types.StringValue(series.GetTitle())
+	s.Path = types.StringValue(series.GetPath())
+	s.RootFolderPath = types.StringValue(series.GetRootFolderPath())
+	s.QualityProfileID = types.Int64Value(int64(series.GetQualityProfileId()))
+	s.Monitored = types.BoolValue(series.GetMonitored())
+	s.SeasonFolder = types.BoolValue(series.GetSeasonFolder())
+	s.SeriesType = types.StringValue(string(series.GetSeriesType()))
+	s.MonitorNewItems = types.StringValue(string(series.GetMonitorNewItems()))
+	s.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, series.GetTags())
+	diags.Append(tempDiag...)
+}
+
+// read builds the series to be imported.
+func (s *SeriesCollectionItem) read(ctx context.Context, tvdbID string, diags *diag.Diagnostics) *sonarr.SeriesResource {
+	id, _ := strconv.Atoi(tvdbID)
+
+	series := sonarr.NewSeriesResource()
+	series.SetTvdbId(int32(id))
+	series.SetRootFolderPath(s.RootFolderPath.ValueString())
+	series.SetQualityProfileId(int32(s.QualityProfileID.ValueInt64()))
+	series.SetMonitored(s.Monitored.ValueBool())
+	series.SetSeasonFolder(s.SeasonFolder.ValueBool())
+	series.SetSeriesType(sonarr.SeriesTypes(s.SeriesType.ValueString()))
+	series.SetMonitorNewItems(sonarr.NewItemMonitorTypes(s.MonitorNewItems.ValueString()))
+	diags.Append(s.Tags.ElementsAs(ctx, &series.Tags, true)...)
+
+	options := sonarr.NewAddSeriesOptions()
+	options.SetMonitor(sonarr.MONITORTYPES_ALL)
+
+	if !s.Monitored.ValueBool() {
+		options.SetMonitor(sonarr.MONITORTYPES_NONE)
+	}
+
+	series.SetAddOptions(*options)
+
+	return series
+}
+
+// editor builds the series editor request applying the item settings.
+func (s *SeriesCollectionItem) editor(ctx context.Context, diags *diag.Diagnostics) *sonarr.SeriesEditorResource {
+	editor := sonarr.NewSeriesEditorResource()
+	editor.SetSeriesIds([]int32{})
+	editor.SetRootFolderPath(s.RootFolderPath.ValueString())
+	editor.SetQualityProfileId(int32(s.QualityProfileID.ValueInt64()))
+	editor.SetMonitored(s.Monitored.ValueBool())
+	editor.SetSeasonFolder(s.SeasonFolder.ValueBool())
+	editor.SetSeriesType(sonarr.SeriesTypes(s.SeriesType.ValueString()))
+	editor.SetMonitorNewItems(sonarr.NewItemMonitorTypes(s.MonitorNewItems.ValueString()))
+	editor.SetApplyTags(sonarr.APPLYTAGS_REPLACE)
+	editor.SetTags([]int32{})
+	diags.Append(s.Tags.ElementsAs(ctx, &editor.Tags, true)...)
+	slices.Sort(editor.Tags)
+
+	return editor
+}
+
+// matches checks if the series already has the item settings.
+func (s *SeriesCollectionItem) matches(ctx context.Context, series *sonarr.SeriesResource, diags *diag.Diagnostics) bool {
+	current := SeriesCollectionItem{}
+	current.write(ctx, series, diags)
+
+	return current.RootFolderPath.Equal(s.RootFolderPath) &&
+		current.QualityProfileID.Equal(s.QualityProfileID) &&
+		current.Monitored.Equal(s.Monitored) &&
+		current.SeasonFolder.Equal(s.SeasonFolder) &&
+		current.SeriesType.Equal(s.SeriesType) &&
+		current.MonitorNewItems.Equal(s.MonitorNewItems) &&
+		current.Tags.Equal(s.Tags)
+}
+
+// collectionID identifies a collection by the sorted TVDB IDs of its series.
+func collectionID(items map[string]SeriesCollectionItem) string {
+	return strings.Join(sortedKeys(items), ",")
+}
+
+// sortedKeys returns the map keys in a stable order.
+func sortedKeys[V any](items map[string]V) []string {
+	keys := make([]string, 0, len(items))
+	for key := range items {
+		keys = append(keys, key)
+	}
+
+	slices.Sort(keys)
+
+	return keys
+}
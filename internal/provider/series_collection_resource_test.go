@@ -0,0 +1,110 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccSeriesCollectionResource(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unauthorized Create
+			{
+				Config:      testAccSeriesCollectionResourceConfig(71663, "/config", "standard", true) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Invalid key
+			{
+				Config:      testAccSeriesCollectionResourceConfig(0, "/config", "standard", true),
+				ExpectError: regexp.MustCompile("must be a TVDB ID"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSeriesCollectionResourceConfig(71663, "/config", "standard", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "id", "71663,76290"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.%", "2"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.71663.title", "The Simpsons"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.71663.path", "/config/The Simpsons"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.76290.monitored", "true"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.76290.series_type", "standard"),
+					resource.TestCheckResourceAttrSet("sonarr_series_collection.test", "series.76290.id"),
+				),
+			},
+			// Unauthorized Read
+			{
+				Config:      testAccSeriesCollectionResourceConfig(71663, "/config", "standard", true) + testUnauthorizedProvider,
+				ExpectError: regexp.MustCompile("Client Error"),
+			},
+			// Update, add and remove testing
+			{
+				Config: testAccSeriesCollectionResourceConfig(78804, "/config/tv", "daily", false),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("sonarr_series_collection.test", tfjsonpath.New("id"), knownvalue.StringExact("76290,78804")),
+						plancheck.ExpectUnknownValue("sonarr_series_collection.test", tfjsonpath.New("series").AtMapKey("76290").AtMapKey("path")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "id", "76290,78804"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.%", "2"),
+					resource.TestCheckNoResourceAttr("sonarr_series_collection.test", "series.71663.id"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.78804.title", "Doctor Who"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.76290.path", "/config/tv/24"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.76290.monitored", "false"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.76290.series_type", "daily"),
+				),
+			},
+			// Update without moving testing
+			{
+				Config: testAccSeriesCollectionResourceConfig(78804, "/config/tv", "standard", true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("sonarr_series_collection.test", tfjsonpath.New("series").AtMapKey("76290").AtMapKey("path"), knownvalue.StringExact("/config/tv/24")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.76290.path", "/config/tv/24"),
+					resource.TestCheckResourceAttr("sonarr_series_collection.test", "series.76290.monitored", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_series_collection.test",
+				ImportState:       true,
+				ImportStateId:     "76290,78804",
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccSeriesCollectionResourceConfig(tvdbID int, rootFolder, seriesType string, monitored bool) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series_collection" "test" {
+		series = {
+			"%d" = {
+				root_folder_path   = "/config"
+				quality_profile_id = 1
+			}
+			"76290" = {
+				root_folder_path   = "%s"
+				quality_profile_id = 1
+				monitored          = %t
+				series_type        = "%s"
+			}
+		}
+	}
+	`, tvdbID, rootFolder, monitored, seriesType)
+}
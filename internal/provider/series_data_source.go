@@ -2,6 +2,7 @@ package provider
 
 import (
 	"context"
+	"maps"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
@@ -80,6 +81,124 @@ func (d *SeriesDataSource) Schema(_ context.Context, _ datasource.SchemaRequest,
 				Computed:            true,
 				ElementType:         types.Int64Type,
 			},
+			"series_type": schema.StringAttribute{
+				MarkdownDescription: "Series type.",
+				Computed:            true,
+			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new items.",
+				Computed:            true,
+			},
+		},
+	}
+	maps.Copy(resp.Schema.Attributes, d.getMetadataSchema().Attributes)
+}
+
+// getMetadataSchema returns the computed series metadata shared by the series data sources and resource.
+func (d SeriesDataSource) getMetadataSchema() schema.Schema {
+	return schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Series status.",
+				Computed:            true,
+			},
+			"overview": schema.StringAttribute{
+				MarkdownDescription: "Overview.",
+				Computed:            true,
+			},
+			"network": schema.StringAttribute{
+				MarkdownDescription: "Network.",
+				Computed:            true,
+			},
+			"certification": schema.StringAttribute{
+				MarkdownDescription: "Certification.",
+				Computed:            true,
+			},
+			"imdb_id": schema.StringAttribute{
+				MarkdownDescription: "IMDB ID.",
+				Computed:            true,
+			},
+			"first_aired": schema.StringAttribute{
+				MarkdownDescription: "First aired date.",
+				Computed:            true,
+			},
+			"original_language": schema.StringAttribute{
+				MarkdownDescription: "Original language.",
+				Computed:            true,
+			},
+			"year": schema.Int64Attribute{
+				MarkdownDescription: "Year.",
+				Computed:            true,
+			},
+			"runtime": schema.Int64Attribute{
+				MarkdownDescription: "Episode runtime in minutes.",
+				Computed:            true,
+			},
+			"genres": schema.SetAttribute{
+				MarkdownDescription: "List of genres.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"alternate_titles": schema.SetAttribute{
+				MarkdownDescription: "List of alternate titles.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"images": schema.SetNestedAttribute{
+				MarkdownDescription: "Series images.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"cover_type": schema.StringAttribute{
+							MarkdownDescription: "Cover type.",
+							Computed:            true,
+						},
+						"url": schema.StringAttribute{
+							MarkdownDescription: "Local URL.",
+							Computed:            true,
+						},
+						"remote_url": schema.StringAttribute{
+							MarkdownDescription: "Remote URL.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+			"statistics": schema.SingleNestedAttribute{
+				MarkdownDescription: "Series statistics.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"season_count": schema.Int64Attribute{
+						MarkdownDescription: "Season count.",
+						Computed:            true,
+					},
+					"episode_count": schema.Int64Attribute{
+						MarkdownDescription: "Monitored or downloaded episode count.",
+						Computed:            true,
+					},
+					"episode_file_count": schema.Int64Attribute{
+						MarkdownDescription: "Episode file count.",
+						Computed:            true,
+					},
+					"total_episode_count": schema.Int64Attribute{
+						MarkdownDescription: "Total episode count.",
+						Computed:            true,
+					},
+					"size_on_disk": schema.Int64Attribute{
+						MarkdownDescription: "Size on disk in bytes.",
+						Computed:            true,
+					},
+					"percent_of_episodes": schema.Float64Attribute{
+						MarkdownDescription: "Percentage of downloaded episodes.",
+						Computed:            true,
+					},
+					"release_groups": schema.SetAttribute{
+						MarkdownDescription: "List of release groups.",
+						Computed:            true,
+						ElementType:         types.StringType,
+					},
+				},
+			},
 		},
 	}
 }
@@ -100,7 +219,7 @@ func (d *SeriesDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 		return
 	}
 	// Get series current value
-	response, _, err := d.client.SeriesAPI.ListSeries(d.auth).Execute()
+	response, _, err := d.client.SeriesAPI.ListSeries(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesDataSourceName, err))
 
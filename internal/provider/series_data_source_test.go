@@ -30,7 +30,10 @@ func TestAccSeriesDataSource(t *testing.T) {
 				Config: testAccSeriesResourceConfig(153021, "The Walking Dead", "the-walking-dead", "false") + testAccSeriesDataSourceConfig("sonarr_series.test.title"),
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.sonarr_series.test", "id"),
-					resource.TestCheckResourceAttr("data.sonarr_series.test", "path", "/config/the-walking-dead")),
+					resource.TestCheckResourceAttr("data.sonarr_series.test", "path", "/config/the-walking-dead"),
+					resource.TestCheckResourceAttr("data.sonarr_series.test", "series_type", "standard"),
+					resource.TestCheckResourceAttr("data.sonarr_series.test", "network", "AMC"),
+					resource.TestCheckResourceAttr("data.sonarr_series.test", "statistics.season_count", "11")),
 			},
 		},
 	})
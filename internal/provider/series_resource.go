@@ -2,27 +2,48 @@ package provider
 
 import (
 	"context"
+	"fmt"
+	"maps"
+	"slices"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/devopsarr/sonarr-go/sonarr"
 	"github.com/devopsarr/terraform-provider-sonarr/internal/helpers"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
 	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
-const seriesResourceName = "series"
+const (
+	seriesResourceName   = "series"
+	moveSeriesCommand    = "MoveSeries"
+	refreshSeriesCommand = "RefreshSeries"
+	seriesDefaultTimeout = 20 * time.Minute
+)
 
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ resource.Resource                = &SeriesResource{}
 	_ resource.ResourceWithImportState = &SeriesResource{}
+	_ resource.ResourceWithModifyPlan  = &SeriesResource{}
 )
 
 func NewSeriesResource() resource.Resource {
@@ -35,16 +56,70 @@ type SeriesResource struct {
 	auth   context.Context
 }
 
+// SeriesResourceModel describes the series resource data model.
+// Besides the Series attributes, shared with data sources, it includes the options used to manage the series.
+type SeriesResourceModel struct {
+	Timeouts                        timeouts.Value `tfsdk:"timeouts"`
+	AddOptions                      types.Object   `tfsdk:"add_options"`
+	Seasons                         types.Set      `tfsdk:"seasons"`
+	Tags                            types.Set      `tfsdk:"tags"`
+	Genres                          types.Set      `tfsdk:"genres"`
+	AlternateTitles                 types.Set      `tfsdk:"alternate_titles"`
+	Images                          types.Set      `tfsdk:"images"`
+	Statistics                      types.Object   `tfsdk:"statistics"`
+	Path                            types.String   `tfsdk:"path"`
+	Title                           types.String   `tfsdk:"title"`
+	TitleSlug                       types.String   `tfsdk:"title_slug"`
+	RootFolderPath                  types.String   `tfsdk:"root_folder_path"`
+	SeriesType                      types.String   `tfsdk:"series_type"`
+	MonitorNewItems                 types.String   `tfsdk:"monitor_new_items"`
+	Status                          types.String   `tfsdk:"status"`
+	Overview                        types.String   `tfsdk:"overview"`
+	Network                         types.String   `tfsdk:"network"`
+	Certification                   types.String   `tfsdk:"certification"`
+	ImdbID                          types.String   `tfsdk:"imdb_id"`
+	FirstAired                      types.String   `tfsdk:"first_aired"`
+	OriginalLanguage                types.String   `tfsdk:"original_language"`
+	ID                              types.Int64    `tfsdk:"id"`
+	QualityProfileID                types.Int64    `tfsdk:"quality_profile_id"`
+	TvdbID                          types.Int64    `tfsdk:"tvdb_id"`
+	Year                            types.Int64    `tfsdk:"year"`
+	Runtime                         types.Int64    `tfsdk:"runtime"`
+	Monitored                       types.Bool     `tfsdk:"monitored"`
+	SeasonFolder                    types.Bool     `tfsdk:"season_folder"`
+	UseSceneNumbering               types.Bool     `tfsdk:"use_scene_numbering"`
+	AdoptExisting                   types.Bool     `tfsdk:"adopt_existing"`
+	WaitForRefresh                  types.Bool     `tfsdk:"wait_for_refresh"`
+	MoveFilesOnPathChange           types.Bool     `tfsdk:"move_files_on_path_change"`
+	DeleteFilesOnDestroy            types.Bool     `tfsdk:"delete_files_on_destroy"`
+	AddImportListExclusionOnDestroy types.Bool     `tfsdk:"add_import_list_exclusion_on_destroy"`
+}
+
 // Series describes the series data model.
 type Series struct {
 	Tags              types.Set    `tfsdk:"tags"`
+	Genres            types.Set    `tfsdk:"genres"`
+	AlternateTitles   types.Set    `tfsdk:"alternate_titles"`
+	Images            types.Set    `tfsdk:"images"`
+	Statistics        types.Object `tfsdk:"statistics"`
 	Path              types.String `tfsdk:"path"`
 	Title             types.String `tfsdk:"title"`
 	TitleSlug         types.String `tfsdk:"title_slug"`
 	RootFolderPath    types.String `tfsdk:"root_folder_path"`
+	SeriesType        types.String `tfsdk:"series_type"`
+	MonitorNewItems   types.String `tfsdk:"monitor_new_items"`
+	Status            types.String `tfsdk:"status"`
+	Overview          types.String `tfsdk:"overview"`
+	Network           types.String `tfsdk:"network"`
+	Certification     types.String `tfsdk:"certification"`
+	ImdbID            types.String `tfsdk:"imdb_id"`
+	FirstAired        types.String `tfsdk:"first_aired"`
+	OriginalLanguage  types.String `tfsdk:"original_language"`
 	ID                types.Int64  `tfsdk:"id"`
 	QualityProfileID  types.Int64  `tfsdk:"quality_profile_id"`
 	TvdbID            types.Int64  `tfsdk:"tvdb_id"`
+	Year              types.Int64  `tfsdk:"year"`
+	Runtime           types.Int64  `tfsdk:"runtime"`
 	Monitored         types.Bool   `tfsdk:"monitored"`
 	SeasonFolder      types.Bool   `tfsdk:"season_folder"`
 	UseSceneNumbering types.Bool   `tfsdk:"use_scene_numbering"`
@@ -63,7 +138,22 @@ func (s Series) getType() attr.Type {
 			"title_slug":          types.StringType,
 			"title":               types.StringType,
 			"path":                types.StringType,
+			"series_type":         types.StringType,
+			"monitor_new_items":   types.StringType,
+			"status":              types.StringType,
+			"overview":            types.StringType,
+			"network":             types.StringType,
+			"certification":       types.StringType,
+			"imdb_id":             types.StringType,
+			"first_aired":         types.StringType,
+			"original_language":   types.StringType,
+			"year":                types.Int64Type,
+			"runtime":             types.Int64Type,
 			"tags":                types.SetType{}.WithElementType(types.Int64Type),
+			"genres":              types.SetType{}.WithElementType(types.StringType),
+			"alternate_titles":    types.SetType{}.WithElementType(types.StringType),
+			"images":              types.SetType{}.WithElementType(Image{}.getType()),
+			"statistics":          SeriesStatistics{}.getType(),
 		})
 }
 
@@ -73,12 +163,21 @@ type Season struct {
 	SeasonNumber types.Int64 `tfsdk:"season_number"`
 }
 
+func (s Season) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"monitored":     types.BoolType,
+			"season_number": types.Int64Type,
+		})
+}
+
 // AddSeriesOptions is used in series creation.
 type AddSeriesOptions struct {
-	SearchForMissingEpisodes     types.Bool `tfsdk:"search_for_missing_episodes"`
-	SearchForCutoffUnmetEpisodes types.Bool `tfsdk:"search_for_cutoff_unmet_episodes"`
-	IgnoreEpisodesWithFiles      types.Bool `tfsdk:"ignore_episodes_with_files"`
-	IgnoreEpisodesWithoutFiles   types.Bool `tfsdk:"ignore_episodes_without_files"`
+	Monitor                      types.String `tfsdk:"monitor"`
+	SearchForMissingEpisodes     types.Bool   `tfsdk:"search_for_missing_episodes"`
+	SearchForCutoffUnmetEpisodes types.Bool   `tfsdk:"search_for_cutoff_unmet_episodes"`
+	IgnoreEpisodesWithFiles      types.Bool   `tfsdk:"ignore_episodes_with_files"`
+	IgnoreEpisodesWithoutFiles   types.Bool   `tfsdk:"ignore_episodes_without_files"`
 }
 
 // Image is part of Series.
@@ -86,25 +185,64 @@ type Image struct {
 	CoverType types.String `tfsdk:"cover_type"`
 	URL       types.String `tfsdk:"url"`
 	RemoteURL types.String `tfsdk:"remote_url"`
-	Extension types.String `tfsdk:"extension"`
+}
+
+func (i Image) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"cover_type": types.StringType,
+			"url":        types.StringType,
+			"remote_url": types.StringType,
+		})
+}
+
+// SeriesStatistics is part of Series.
+type SeriesStatistics struct {
+	ReleaseGroups     types.Set     `tfsdk:"release_groups"`
+	SeasonCount       types.Int64   `tfsdk:"season_count"`
+	EpisodeCount      types.Int64   `tfsdk:"episode_count"`
+	EpisodeFileCount  types.Int64   `tfsdk:"episode_file_count"`
+	TotalEpisodeCount types.Int64   `tfsdk:"total_episode_count"`
+	SizeOnDisk        types.Int64   `tfsdk:"size_on_disk"`
+	PercentOfEpisodes types.Float64 `tfsdk:"percent_of_episodes"`
+}
+
+func (s SeriesStatistics) getType() attr.Type {
+	return types.ObjectType{}.WithAttributeTypes(
+		map[string]attr.Type{
+			"release_groups":      types.SetType{}.WithElementType(types.StringType),
+			"season_count":        types.Int64Type,
+			"episode_count":       types.Int64Type,
+			"episode_file_count":  types.Int64Type,
+			"total_episode_count": types.Int64Type,
+			"size_on_disk":        types.Int64Type,
+			"percent_of_episodes": types.Float64Type,
+		})
 }
 
 func (r *SeriesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_" + seriesResourceName
 }
 
-func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
-	// TODO: waiting to implement seasons and images until empty conversion is managed natively https://www.terraform.io/plugin/framework/accessing-values#conversion-rules
+func (r *SeriesResource) Schema(ctx context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
 		MarkdownDescription: "<!-- subcategory:Series -->\nSeries resource.\nFor more information refer to [Series](https://wiki.servarr.com/sonarr/library#series) documentation.",
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
 		Attributes: map[string]schema.Attribute{
 			"title": schema.StringAttribute{
-				MarkdownDescription: "Series Title.",
-				Required:            true,
+				MarkdownDescription: "Series Title. If unset, it is looked up from `tvdb_id`.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"title_slug": schema.StringAttribute{
-				MarkdownDescription: "Series Title in kebab format.",
-				Required:            true,
+				MarkdownDescription: "Series Title in kebab format. If unset, it is looked up from `tvdb_id`.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"monitored": schema.BoolAttribute{
 				MarkdownDescription: "Monitored flag.",
@@ -127,8 +265,9 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:            true,
 			},
 			"path": schema.StringAttribute{
-				MarkdownDescription: "Series Path.",
-				Required:            true,
+				MarkdownDescription: "Series Path. If unset, it is the `root_folder_path` joined with the series folder built by Sonarr from the `sonarr_naming` `series_folder_format`.",
+				Optional:            true,
+				Computed:            true,
 			},
 			"root_folder_path": schema.StringAttribute{
 				MarkdownDescription: "Series Root Folder.",
@@ -147,8 +286,189 @@ func (r *SeriesResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 					int64planmodifier.UseStateForUnknown(),
 				},
 			},
+			"series_type": schema.StringAttribute{
+				MarkdownDescription: "Series type. Valid values are 'standard', 'daily' and 'anime'. Defaults to `standard`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(sonarr.SERIESTYPES_STANDARD)),
+				Validators: []validator.String{
+					stringvalidator.OneOf("standard", "daily", "anime"),
+				},
+			},
+			"monitor_new_items": schema.StringAttribute{
+				MarkdownDescription: "Monitor new seasons. Valid values are 'all' and 'none'. Defaults to `all`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(sonarr.NEWITEMMONITORTYPES_ALL)),
+				Validators: []validator.String{
+					stringvalidator.OneOf("all", "none"),
+				},
+			},
+			"seasons": schema.SetNestedAttribute{
+				MarkdownDescription: "Seasons monitoring. Only the listed seasons are managed: the others keep their monitoring in Sonarr and are not stored in state. If unset, no season is managed. On create, the seasons are applied after Sonarr completes the refresh of the added series, which applies `add_options.monitor`.",
+				Optional:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"season_number": schema.Int64Attribute{
+							MarkdownDescription: "Season number.",
+							Required:            true,
+						},
+						"monitored": schema.BoolAttribute{
+							MarkdownDescription: "Monitored flag.",
+							Required:            true,
+						},
+					},
+				},
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Take over the series on create if it is already in Sonarr, instead of failing. The planned settings are applied to it, while `add_options` are ignored. The planned `path` must match the existing one, as adopting never moves files. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"wait_for_refresh": schema.BoolAttribute{
+				MarkdownDescription: "Wait on create for Sonarr to complete the refresh of the added series, so that its episodes and files are available to other resources. The wait is bounded by the `create` timeout. Always done when `seasons` is set. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"move_files_on_path_change": schema.BoolAttribute{
+				MarkdownDescription: "Move the series files to the new folder when `path` changes, waiting for Sonarr to complete the move. Defaults to `true`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(true),
+			},
+			"delete_files_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Delete the series folder and its files when the resource is destroyed. The value is read from state: set it in an apply before the one destroying the resource. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"add_import_list_exclusion_on_destroy": schema.BoolAttribute{
+				MarkdownDescription: "Add an import list exclusion for the series when the resource is destroyed, so that import lists do not add it again. The value is read from state: set it in an apply before the one destroying the resource. Defaults to `false`.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"add_options": schema.SingleNestedAttribute{
+				MarkdownDescription: "Options used only when the series is added, changing them later has no effect. If unset, all episodes are monitored and missing and cutoff unmet episodes are searched.",
+				Optional:            true,
+				Attributes: map[string]schema.Attribute{
+					"monitor": schema.StringAttribute{
+						MarkdownDescription: "Episodes to monitor. Valid values are 'all', 'future', 'missing', 'existing', 'pilot', 'firstSeason', 'lastSeason' and 'none'. Defaults to `all`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             stringdefault.StaticString(string(sonarr.MONITORTYPES_ALL)),
+						Validators: []validator.String{
+							stringvalidator.OneOf("all", "future", "missing", "existing", "pilot", "firstSeason", "lastSeason", "none"),
+						},
+					},
+					"search_for_missing_episodes": schema.BoolAttribute{
+						MarkdownDescription: "Search for missing episodes after adding. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"search_for_cutoff_unmet_episodes": schema.BoolAttribute{
+						MarkdownDescription: "Search for cutoff unmet episodes after adding. Defaults to `true`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(true),
+					},
+					"ignore_episodes_with_files": schema.BoolAttribute{
+						MarkdownDescription: "Unmonitor episodes with files. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+					"ignore_episodes_without_files": schema.BoolAttribute{
+						MarkdownDescription: "Unmonitor episodes without files. Defaults to `false`.",
+						Optional:            true,
+						Computed:            true,
+						Default:             booldefault.StaticBool(false),
+					},
+				},
+			},
 		},
 	}
+	maps.Copy(resp.Schema.Attributes, r.getMetadataSchema(&resp.Diagnostics).Attributes)
+}
+
+// getMetadataSchema returns the series data source metadata as computed attributes.
+// Metadata does not change on update, except statistics that follow the monitoring.
+func (r SeriesResource) getMetadataSchema(diags *diag.Diagnostics) schema.Schema {
+	attributes := make(map[string]schema.Attribute)
+
+	for name, attribute := range (SeriesDataSource{}).getMetadataSchema().Attributes {
+		if output := r.computedAttribute(name, attribute, name != "statistics", diags); output != nil {
+			attributes[name] = output
+		}
+	}
+
+	return schema.Schema{Attributes: attributes}
+}
+
+// computedAttribute maps a computed data source attribute to the resource one, optionally keeping its state on update.
+// Unsupported attribute types are reported as error and return nil.
+func (r SeriesResource) computedAttribute(name string, attribute datasourceschema.Attribute, keepState bool, diags *diag.Diagnostics) schema.Attribute {
+	description := attribute.GetMarkdownDescription()
+
+	switch attribute := attribute.(type) {
+	case datasourceschema.StringAttribute:
+		output := schema.StringAttribute{MarkdownDescription: description, Computed: true}
+		if keepState {
+			output.PlanModifiers = []planmodifier.String{stringplanmodifier.UseStateForUnknown()}
+		}
+
+		return output
+	case datasourceschema.Int64Attribute:
+		output := schema.Int64Attribute{MarkdownDescription: description, Computed: true}
+		if keepState {
+			output.PlanModifiers = []planmodifier.Int64{int64planmodifier.UseStateForUnknown()}
+		}
+
+		return output
+	case datasourceschema.Float64Attribute:
+		return schema.Float64Attribute{MarkdownDescription: description, Computed: true}
+	case datasourceschema.SetAttribute:
+		output := schema.SetAttribute{MarkdownDescription: description, Computed: true, ElementType: attribute.ElementType}
+		if keepState {
+			output.PlanModifiers = []planmodifier.Set{setplanmodifier.UseStateForUnknown()}
+		}
+
+		return output
+	case datasourceschema.SetNestedAttribute:
+		output := schema.SetNestedAttribute{MarkdownDescription: description, Computed: true}
+		output.NestedObject.Attributes = r.computedAttributes(attribute.NestedObject.Attributes, diags)
+
+		if keepState {
+			output.PlanModifiers = []planmodifier.Set{setplanmodifier.UseStateForUnknown()}
+		}
+
+		return output
+	case datasourceschema.SingleNestedAttribute:
+		return schema.SingleNestedAttribute{MarkdownDescription: description, Computed: true, Attributes: r.computedAttributes(attribute.Attributes, diags)}
+	}
+
+	diags.AddError(
+		"Unsupported series metadata attribute",
+		fmt.Sprintf("Unable to map the series data source attribute %s of type %T to the resource schema. Please report this issue to the provider developers.", name, attribute),
+	)
+
+	return nil
+}
+
+// computedAttributes maps nested attributes, which follow their parent plan.
+func (r SeriesResource) computedAttributes(attributes map[string]datasourceschema.Attribute, diags *diag.Diagnostics) map[string]schema.Attribute {
+	output := make(map[string]schema.Attribute, len(attributes))
+
+	for name, attribute := range attributes {
+		if computed := r.computedAttribute(name, attribute, false, diags); computed != nil {
+			output[name] = computed
+		}
+	}
+
+	return output
 }
 
 func (r *SeriesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
@@ -158,9 +478,109 @@ func (r *SeriesResource) Configure(ctx context.Context, req resource.ConfigureRe
 	}
 }
 
+func (r *SeriesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	// nothing to resolve on destroy or with an unconfigured provider
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan, state *SeriesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// keep the values of the existing series, moving it if the root folder changes
+	if state != nil && state.TvdbID.Equal(plan.TvdbID) {
+		if plan.Title.IsUnknown() {
+			plan.Title = state.Title
+		}
+
+		if plan.TitleSlug.IsUnknown() {
+			plan.TitleSlug = state.TitleSlug
+		}
+
+		if plan.Path.IsUnknown() && !plan.RootFolderPath.IsUnknown() {
+			plan.Path = state.Path
+			if !plan.RootFolderPath.Equal(state.RootFolderPath) {
+				plan.Path = types.StringValue(joinSeriesPath(plan.RootFolderPath.ValueString(), seriesFolderName(state.Path.ValueString())))
+			}
+		}
+	}
+
+	r.lookupSeries(ctx, plan, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+// lookupSeries resolves the unknown title, title slug and path through the series lookup.
+// Nothing is done if the TVDB ID or the root folder are not known yet.
+func (r *SeriesResource) lookupSeries(ctx context.Context, series *SeriesResourceModel, diags *diag.Diagnostics) {
+	if !series.Title.IsUnknown() && !series.TitleSlug.IsUnknown() && !series.Path.IsUnknown() {
+		return
+	}
+
+	if series.TvdbID.IsUnknown() || series.RootFolderPath.IsUnknown() {
+		return
+	}
+
+	tvdbID := strconv.Itoa(int(series.TvdbID.ValueInt64()))
+
+	response, _, err := r.client.SeriesLookupAPI.ListSeriesLookup(helpers.WithAuth(ctx, r.auth)).Term("tvdb:" + tvdbID).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
+
+		return
+	}
+
+	index := slices.IndexFunc(response, func(s sonarr.SeriesResource) bool { return int64(s.GetTvdbId()) == series.TvdbID.ValueInt64() })
+	if index < 0 {
+		diags.AddAttributeError(path.Root("tvdb_id"), helpers.ResourceError, helpers.ParseNotFoundError(seriesResourceName, "TVDBID", tvdbID))
+
+		return
+	}
+
+	lookup := &response[index]
+	tflog.Trace(ctx, "looked up "+seriesResourceName+": "+tvdbID)
+
+	if series.Title.IsUnknown() {
+		series.Title = types.StringValue(lookup.GetTitle())
+	}
+
+	if series.TitleSlug.IsUnknown() {
+		series.TitleSlug = types.StringValue(lookup.GetTitleSlug())
+	}
+
+	if !series.Path.IsUnknown() {
+		return
+	}
+
+	// older Sonarr versions do not return the folder, build it from the naming format
+	folder := lookup.GetFolder()
+	if folder == "" {
+		naming, _, err := r.client.NamingConfigAPI.GetNamingConfig(helpers.WithAuth(ctx, r.auth)).Execute()
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
+
+			return
+		}
+
+		folder = renderSeriesFolder(naming.GetSeriesFolderFormat(), lookup)
+	}
+
+	series.Path = types.StringValue(joinSeriesPath(series.RootFolderPath.ValueString(), folder))
+}
+
 func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	// Retrieve values from plan
-	var series *Series
+	var series *SeriesResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &series)...)
 
@@ -168,24 +588,111 @@ func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest,
 		return
 	}
 
+	createTimeout, diags := series.Timeouts.Create(ctx, seriesDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	// Resolve the values unknown at plan time
+	r.lookupSeries(ctx, series, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Take over the series already in Sonarr
+	if series.AdoptExisting.ValueBool() {
+		existing := r.findExisting(ctx, series, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		if existing != nil {
+			// Never move the files of an adopted series
+			if existing.GetPath() != series.Path.ValueString() {
+				resp.Diagnostics.AddAttributeError(path.Root("path"), helpers.ResourceError,
+					fmt.Sprintf("Series '%s' is already in Sonarr at '%s', set the same path to adopt it", existing.GetTitle(), existing.GetPath()))
+
+				return
+			}
+
+			response := r.update(ctx, helpers.Create, series, existing, req.Plan, &resp.Diagnostics)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+
+			tflog.Trace(ctx, "adopted "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
+			series.write(ctx, response, &resp.Diagnostics)
+			resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
+
+			return
+		}
+	}
+
 	// Create new Series
 	request := series.read(ctx, &resp.Diagnostics)
-	// TODO: can parametrize AddSeriesOptions
-	options := sonarr.NewAddSeriesOptions()
-	options.SetSearchForMissingEpisodes(true)
-	options.SetSearchForCutoffUnmetEpisodes(true)
-	options.SetIgnoreEpisodesWithFiles(false)
-	options.SetIgnoreEpisodesWithoutFiles(false)
+	request.SetAddOptions(*series.readAddOptions(ctx, &resp.Diagnostics))
+
+	if !series.Seasons.IsNull() {
+		request.SetSeasons(series.readSeasons(ctx, nil, &resp.Diagnostics))
+	}
+
+	// Sonarr refreshes the series in background, through a command queued by the creation.
+	// The refresh applies the monitor add option, overwriting the seasons monitoring set before it completes.
+	waitForRefresh := series.WaitForRefresh.ValueBool() || !series.Seasons.IsNull()
+
+	var lastRefresh int32
 
-	request.SetAddOptions(*options)
+	if waitForRefresh {
+		var err error
 
-	response, _, err := r.client.SeriesAPI.CreateSeries(r.auth).SeriesResource(*request).Execute()
+		lastRefresh, err = helpers.LastCommandID(helpers.WithAuth(ctx, r.auth), r.client, refreshSeriesCommand)
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, seriesResourceName, err))
+
+			return
+		}
+	}
+
+	response, _, err := r.client.SeriesAPI.CreateSeries(helpers.WithAuth(ctx, r.auth)).SeriesResource(*request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, seriesResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, seriesResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
 
+	if waitForRefresh {
+		response = r.waitForRefresh(ctx, response.GetId(), lastRefresh, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Keep the created series in state on error, so that it is replaced on the next apply
+	if series.checkSeasons(ctx, response.GetSeasons(), &resp.Diagnostics); resp.Diagnostics.HasError() {
+		series.write(ctx, response, &resp.Diagnostics)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
+
+		return
+	}
+
+	// Sonarr can change the seasons monitoring while adding the series
+	if !series.hasSeasons(ctx, response.GetSeasons(), &resp.Diagnostics) {
+		response.SetSeasons(series.readSeasons(ctx, response.GetSeasons(), &resp.Diagnostics))
+
+		response, _, err = r.client.SeriesAPI.UpdateSeries(helpers.WithAuth(ctx, r.auth), strconv.Itoa(int(response.GetId()))).SeriesResource(*response).Execute()
+		if err != nil {
+			resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, seriesResourceName, err))
+
+			return
+		}
+	}
+
 	tflog.Trace(ctx, "created "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Generate resource state struct
 	series.write(ctx, response, &resp.Diagnostics)
@@ -194,7 +701,7 @@ func (r *SeriesResource) Create(ctx context.Context, req resource.CreateRequest,
 
 func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
 	// Get current state
-	var series *Series
+	var series *SeriesResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &series)...)
 
@@ -203,9 +710,9 @@ func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, res
 	}
 
 	// Get series current value
-	response, _, err := r.client.SeriesAPI.GetSeriesById(r.auth, int32(series.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.SeriesAPI.GetSeriesById(helpers.WithAuth(ctx, r.auth), int32(series.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, seriesResourceName, err))
+		helpers.HandleReadError(ctx, seriesResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -218,7 +725,7 @@ func (r *SeriesResource) Read(ctx context.Context, req resource.ReadRequest, res
 
 func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
 	// Get plan values
-	var series *Series
+	var series *SeriesResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &series)...)
 
@@ -226,35 +733,145 @@ func (r *SeriesResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
-	// Update Series
-	request := series.read(ctx, &resp.Diagnostics)
+	updateTimeout, diags := series.Timeouts.Update(ctx, seriesDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	// TODO: manage movefiles on sdk
-	response, _, err := r.client.SeriesAPI.UpdateSeries(r.auth, strconv.Itoa(int(request.GetId()))).SeriesResource(*request).Execute()
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	current, _, err := r.client.SeriesAPI.GetSeriesById(helpers.WithAuth(ctx, r.auth), int32(series.ID.ValueInt64())).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, seriesResourceName, err))
 
 		return
 	}
 
+	// Resolve the path unknown at plan time, keeping the series folder
+	if series.Path.IsUnknown() {
+		series.Path = types.StringValue(joinSeriesPath(series.RootFolderPath.ValueString(), seriesFolderName(current.GetPath())))
+	}
+
+	r.lookupSeries(ctx, series, &resp.Diagnostics)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	response := r.update(ctx, helpers.Update, series, current, req.Plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	tflog.Trace(ctx, "updated "+seriesResourceName+": "+strconv.Itoa(int(response.GetId())))
 	// Map response body to resource schema attribute
 	series.write(ctx, response, &resp.Diagnostics)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &series)...)
 }
 
+// waitForRefresh waits for the refresh commands of the series queued after the given one, returning the refreshed series.
+func (r *SeriesResource) waitForRefresh(ctx context.Context, id, after int32, diags *diag.Diagnostics) *sonarr.SeriesResource {
+	auth := helpers.WithAuth(ctx, r.auth)
+
+	if err := helpers.WaitForCommands(auth, r.client, refreshSeriesCommand, after, id); err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, seriesResourceName, err))
+
+		return nil
+	}
+
+	response, _, err := r.client.SeriesAPI.GetSeriesById(auth, id).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, seriesResourceName, err))
+
+		return nil
+	}
+
+	return response
+}
+
+// update applies the planned series to the current one, keeping the seasons not managed by terraform.
+func (r *SeriesResource) update(ctx context.Context, action string, series *SeriesResourceModel, current *sonarr.SeriesResource, plan tfsdk.Plan, diags *diag.Diagnostics) *sonarr.SeriesResource {
+	if series.checkSeasons(ctx, current.GetSeasons(), diags); diags.HasError() {
+		return nil
+	}
+
+	request := series.read(ctx, diags)
+	request.SetId(current.GetId())
+	request.SetSeasons(series.readSeasons(ctx, current.GetSeasons(), diags))
+
+	// Sonarr moves the files in background, through a command queued by the update
+	moveFiles := series.MoveFilesOnPathChange.ValueBool() && current.GetPath() != request.GetPath()
+	auth := helpers.WithAuth(ctx, r.auth)
+
+	var (
+		lastMove int32
+		err      error
+	)
+
+	if moveFiles {
+		lastMove, err = helpers.LastCommandID(auth, r.client, moveSeriesCommand)
+		if err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesResourceName, err))
+
+			return nil
+		}
+	}
+
+	response, _, err := r.client.SeriesAPI.UpdateSeries(auth, strconv.Itoa(int(request.GetId()))).MoveFiles(moveFiles).SeriesResource(*request).Execute()
+	if err != nil {
+		helpers.HandleWriteError(ctx, action, seriesResourceName, err, helpers.Fields{}, plan, diags)
+
+		return nil
+	}
+
+	if moveFiles {
+		if err := helpers.WaitForCommands(auth, r.client, moveSeriesCommand, lastMove, request.GetId()); err != nil {
+			diags.AddError(helpers.ClientError, helpers.ParseClientError(action, seriesResourceName, err))
+
+			return nil
+		}
+	}
+
+	return response
+}
+
+// findExisting returns the series with the planned TVDB ID if already in Sonarr, nil otherwise.
+func (r *SeriesResource) findExisting(ctx context.Context, series *SeriesResourceModel, diags *diag.Diagnostics) *sonarr.SeriesResource {
+	response, _, err := r.client.SeriesAPI.ListSeries(helpers.WithAuth(ctx, r.auth)).TvdbId(int32(series.TvdbID.ValueInt64())).Execute()
+	if err != nil {
+		diags.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, seriesResourceName, err))
+
+		return nil
+	}
+
+	for i := range response {
+		if int64(response[i].GetTvdbId()) == series.TvdbID.ValueInt64() {
+			return &response[i]
+		}
+	}
+
+	return nil
+}
+
 func (r *SeriesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
-	var ID int64
+	var series *SeriesResourceModel
 
-	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &ID)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &series)...)
 
 	if resp.Diagnostics.HasError() {
 		return
 	}
 
+	ID := series.ID.ValueInt64()
+
 	// Delete series current value
-	// TODO: manage delete parameters on SDK
-	_, err := r.client.SeriesAPI.DeleteSeries(r.auth, int32(ID)).Execute()
+	_, err := r.client.SeriesAPI.DeleteSeries(helpers.WithAuth(ctx, r.auth), int32(ID)).
+		DeleteFiles(series.DeleteFilesOnDestroy.ValueBool()).
+		AddImportListExclusion(series.AddImportListExclusionOnDestroy.ValueBool()).
+		Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, seriesResourceName, err))
 
@@ -270,6 +887,233 @@ func (r *SeriesResource) ImportState(ctx context.Context, req resource.ImportSta
 	tflog.Trace(ctx, "imported "+seriesResourceName+": "+req.ID)
 }
 
+func (s *SeriesResourceModel) write(ctx context.Context, series *sonarr.SeriesResource, diags *diag.Diagnostics) {
+	data := Series{}
+	data.write(ctx, series, diags)
+	s.fromSeries(&data)
+	s.writeSeasons(ctx, series.GetSeasons(), diags)
+
+	// management options are not stored in Sonarr, imported series get the defaults
+	if s.AdoptExisting.IsNull() {
+		s.AdoptExisting = types.BoolValue(false)
+	}
+
+	if s.WaitForRefresh.IsNull() {
+		s.WaitForRefresh = types.BoolValue(false)
+	}
+
+	if s.MoveFilesOnPathChange.IsNull() {
+		s.MoveFilesOnPathChange = types.BoolValue(true)
+	}
+
+	if s.DeleteFilesOnDestroy.IsNull() {
+		s.DeleteFilesOnDestroy = types.BoolValue(false)
+	}
+
+	if s.AddImportListExclusionOnDestroy.IsNull() {
+		s.AddImportListExclusionOnDestroy = types.BoolValue(false)
+	}
+}
+
+// writeSeasons stores the managed seasons only.
+func (s *SeriesResourceModel) writeSeasons(ctx context.Context, seasons []sonarr.SeasonResource, diags *diag.Diagnostics) {
+	if s.Seasons.IsNull() || s.Seasons.IsUnknown() {
+		s.Seasons = types.SetNull(Season{}.getType())
+
+		return
+	}
+
+	managed := s.managedSeasons(ctx, diags)
+	stateSeasons := make([]Season, 0, len(managed))
+
+	for _, season := range seasons {
+		if _, ok := managed[int64(season.GetSeasonNumber())]; ok {
+			stateSeasons = append(stateSeasons, Season{
+				SeasonNumber: types.Int64Value(int64(season.GetSeasonNumber())),
+				Monitored:    types.BoolValue(season.GetMonitored()),
+			})
+		}
+	}
+
+	var tempDiag diag.Diagnostics
+
+	s.Seasons, tempDiag = types.SetValueFrom(ctx, Season{}.getType(), stateSeasons)
+	diags.Append(tempDiag...)
+}
+
+// readSeasons merges the managed seasons into the current ones.
+func (s *SeriesResourceModel) readSeasons(ctx context.Context, current []sonarr.SeasonResource, diags *diag.Diagnostics) []sonarr.SeasonResource {
+	managed := s.managedSeasons(ctx, diags)
+	seasons := make([]sonarr.SeasonResource, 0, len(current)+len(managed))
+
+	for _, season := range current {
+		if monitored, ok := managed[int64(season.GetSeasonNumber())]; ok {
+			season.SetMonitored(monitored)
+			delete(managed, int64(season.GetSeasonNumber()))
+		}
+
+		seasons = append(seasons, season)
+	}
+
+	numbers := make([]int64, 0, len(managed))
+	for number := range managed {
+		numbers = append(numbers, number)
+	}
+
+	slices.Sort(numbers)
+
+	for _, number := range numbers {
+		season := sonarr.NewSeasonResource()
+		season.SetSeasonNumber(int32(number))
+		season.SetMonitored(managed[number])
+		seasons = append(seasons, *season)
+	}
+
+	return seasons
+}
+
+// hasSeasons checks if the managed seasons monitoring matches the given seasons.
+func (s *SeriesResourceModel) hasSeasons(ctx context.Context, seasons []sonarr.SeasonResource, diags *diag.Diagnostics) bool {
+	managed := s.managedSeasons(ctx, diags)
+
+	for _, season := range seasons {
+		if monitored, ok := managed[int64(season.GetSeasonNumber())]; ok {
+			if monitored != season.GetMonitored() {
+				return false
+			}
+
+			delete(managed, int64(season.GetSeasonNumber()))
+		}
+	}
+
+	return len(managed) == 0
+}
+
+// checkSeasons reports the managed seasons missing from the given ones, since Sonarr ignores them.
+func (s *SeriesResourceModel) checkSeasons(ctx context.Context, seasons []sonarr.SeasonResource, diags *diag.Diagnostics) {
+	managed := s.managedSeasons(ctx, diags)
+	numbers := make([]string, 0, len(seasons))
+
+	for _, season := range seasons {
+		delete(managed, int64(season.GetSeasonNumber()))
+		numbers = append(numbers, strconv.Itoa(int(season.GetSeasonNumber())))
+	}
+
+	missing := make([]int64, 0, len(managed))
+	for number := range managed {
+		missing = append(missing, number)
+	}
+
+	slices.Sort(missing)
+
+	for _, number := range missing {
+		diags.AddAttributeError(path.Root("seasons"), helpers.ResourceError,
+			fmt.Sprintf("Series '%s' has no season %d, available seasons: %s", s.Title.ValueString(), number, strings.Join(numbers, ", ")))
+	}
+}
+
+// managedSeasons returns the monitored flag of the seasons set in terraform by season number.
+func (s *SeriesResourceModel) managedSeasons(ctx context.Context, diags *diag.Diagnostics) map[int64]bool {
+	seasons := make([]Season, len(s.Seasons.Elements()))
+	diags.Append(s.Seasons.ElementsAs(ctx, &seasons, true)...)
+
+	managed := make(map[int64]bool, len(seasons))
+	for _, season := range seasons {
+		managed[season.SeasonNumber.ValueInt64()] = season.Monitored.ValueBool()
+	}
+
+	return managed
+}
+
+func (s *SeriesResourceModel) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.SeriesResource {
+	return s.toSeries().read(ctx, diags)
+}
+
+// readAddOptions returns the add options, keeping the search on add if unset.
+func (s *SeriesResourceModel) readAddOptions(ctx context.Context, diags *diag.Diagnostics) *sonarr.AddSeriesOptions {
+	options := sonarr.NewAddSeriesOptions()
+
+	if s.AddOptions.IsNull() || s.AddOptions.IsUnknown() {
+		options.SetSearchForMissingEpisodes(true)
+		options.SetSearchForCutoffUnmetEpisodes(true)
+		options.SetIgnoreEpisodesWithFiles(false)
+		options.SetIgnoreEpisodesWithoutFiles(false)
+
+		return options
+	}
+
+	addOptions := AddSeriesOptions{}
+	diags.Append(s.AddOptions.As(ctx, &addOptions, basetypes.ObjectAsOptions{})...)
+
+	options.SetMonitor(sonarr.MonitorTypes(addOptions.Monitor.ValueString()))
+	options.SetSearchForMissingEpisodes(addOptions.SearchForMissingEpisodes.ValueBool())
+	options.SetSearchForCutoffUnmetEpisodes(addOptions.SearchForCutoffUnmetEpisodes.ValueBool())
+	options.SetIgnoreEpisodesWithFiles(addOptions.IgnoreEpisodesWithFiles.ValueBool())
+	options.SetIgnoreEpisodesWithoutFiles(addOptions.IgnoreEpisodesWithoutFiles.ValueBool())
+
+	return options
+}
+
+func (s *SeriesResourceModel) toSeries() *Series {
+	return &Series{
+		Tags:              s.Tags,
+		Genres:            s.Genres,
+		AlternateTitles:   s.AlternateTitles,
+		Images:            s.Images,
+		Statistics:        s.Statistics,
+		Path:              s.Path,
+		Title:             s.Title,
+		TitleSlug:         s.TitleSlug,
+		RootFolderPath:    s.RootFolderPath,
+		SeriesType:        s.SeriesType,
+		MonitorNewItems:   s.MonitorNewItems,
+		Status:            s.Status,
+		Overview:          s.Overview,
+		Network:           s.Network,
+		Certification:     s.Certification,
+		ImdbID:            s.ImdbID,
+		FirstAired:        s.FirstAired,
+		OriginalLanguage:  s.OriginalLanguage,
+		ID:                s.ID,
+		QualityProfileID:  s.QualityProfileID,
+		TvdbID:            s.TvdbID,
+		Year:              s.Year,
+		Runtime:           s.Runtime,
+		Monitored:         s.Monitored,
+		SeasonFolder:      s.SeasonFolder,
+		UseSceneNumbering: s.UseSceneNumbering,
+	}
+}
+
+func (s *SeriesResourceModel) fromSeries(series *Series) {
+	s.Tags = series.Tags
+	s.Genres = series.Genres
+	s.AlternateTitles = series.AlternateTitles
+	s.Images = series.Images
+	s.Statistics = series.Statistics
+	s.Path = series.Path
+	s.Title = series.Title
+	s.TitleSlug = series.TitleSlug
+	s.RootFolderPath = series.RootFolderPath
+	s.SeriesType = series.SeriesType
+	s.MonitorNewItems = series.MonitorNewItems
+	s.Status = series.Status
+	s.Overview = series.Overview
+	s.Network = series.Network
+	s.Certification = series.Certification
+	s.ImdbID = series.ImdbID
+	s.FirstAired = series.FirstAired
+	s.OriginalLanguage = series.OriginalLanguage
+	s.ID = series.ID
+	s.QualityProfileID = series.QualityProfileID
+	s.TvdbID = series.TvdbID
+	s.Year = series.Year
+	s.Runtime = series.Runtime
+	s.Monitored = series.Monitored
+	s.SeasonFolder = series.SeasonFolder
+	s.UseSceneNumbering = series.UseSceneNumbering
+}
+
 func (s *Series) write(ctx context.Context, series *sonarr.SeriesResource, diags *diag.Diagnostics) {
 	var tempDiag diag.Diagnostics
 
@@ -283,8 +1127,78 @@ func (s *Series) write(ctx context.Context, series *sonarr.SeriesResource, diags
 	s.Title = types.StringValue(series.GetTitle())
 	s.TitleSlug = types.StringValue(series.GetTitleSlug())
 	s.RootFolderPath = types.StringValue(series.GetRootFolderPath())
+	s.SeriesType = types.StringValue(string(series.GetSeriesType()))
+	s.MonitorNewItems = types.StringValue(string(series.GetMonitorNewItems()))
+	s.Status = types.StringValue(string(series.GetStatus()))
+	s.Overview = types.StringValue(series.GetOverview())
+	s.Network = types.StringValue(series.GetNetwork())
+	s.Certification = types.StringValue(series.GetCertification())
+	s.ImdbID = types.StringValue(series.GetImdbId())
+	s.OriginalLanguage = types.StringValue(series.OriginalLanguage.GetName())
+	s.Year = types.Int64Value(int64(series.GetYear()))
+	s.Runtime = types.Int64Value(int64(series.GetRuntime()))
+
+	s.FirstAired = types.StringNull()
+	if firstAired, ok := series.GetFirstAiredOk(); ok && firstAired != nil {
+		s.FirstAired = types.StringValue(firstAired.Format(time.RFC3339))
+	}
+
 	s.Tags, tempDiag = types.SetValueFrom(ctx, types.Int64Type, series.GetTags())
 	diags.Append(tempDiag...)
+	s.Genres, tempDiag = types.SetValueFrom(ctx, types.StringType, series.GetGenres())
+	diags.Append(tempDiag...)
+	s.writeAlternateTitles(ctx, series.GetAlternateTitles(), diags)
+	s.writeImages(ctx, series.GetImages(), diags)
+	s.writeStatistics(ctx, series.GetStatistics(), diags)
+}
+
+func (s *Series) writeAlternateTitles(ctx context.Context, alternateTitles []sonarr.AlternateTitleResource, diags *diag.Diagnostics) {
+	titles := make([]string, len(alternateTitles))
+	for i, t := range alternateTitles {
+		titles[i] = t.GetTitle()
+	}
+
+	var tempDiag diag.Diagnostics
+
+	s.AlternateTitles, tempDiag = types.SetValueFrom(ctx, types.StringType, titles)
+	diags.Append(tempDiag...)
+}
+
+func (s *Series) writeImages(ctx context.Context, covers []sonarr.MediaCover, diags *diag.Diagnostics) {
+	images := make([]Image, len(covers))
+	for i, c := range covers {
+		images[i].write(&c)
+	}
+
+	var tempDiag diag.Diagnostics
+
+	s.Images, tempDiag = types.SetValueFrom(ctx, Image{}.getType(), images)
+	diags.Append(tempDiag...)
+}
+
+func (s *Series) writeStatistics(ctx context.Context, statistics sonarr.SeriesStatisticsResource, diags *diag.Diagnostics) {
+	var tempDiag diag.Diagnostics
+
+	stats := SeriesStatistics{
+		SeasonCount:       types.Int64Value(int64(statistics.GetSeasonCount())),
+		EpisodeCount:      types.Int64Value(int64(statistics.GetEpisodeCount())),
+		EpisodeFileCount:  types.Int64Value(int64(statistics.GetEpisodeFileCount())),
+		TotalEpisodeCount: types.Int64Value(int64(statistics.GetTotalEpisodeCount())),
+		SizeOnDisk:        types.Int64Value(statistics.GetSizeOnDisk()),
+		PercentOfEpisodes: types.Float64Value(statistics.GetPercentOfEpisodes()),
+	}
+
+	stats.ReleaseGroups, tempDiag = types.SetValueFrom(ctx, types.StringType, statistics.GetReleaseGroups())
+	diags.Append(tempDiag...)
+
+	s.Statistics, tempDiag = types.ObjectValueFrom(ctx, SeriesStatistics{}.getType().(attr.TypeWithAttributeTypes).AttributeTypes(), stats)
+	diags.Append(tempDiag...)
+}
+
+func (i *Image) write(cover *sonarr.MediaCover) {
+	i.CoverType = types.StringValue(string(cover.GetCoverType()))
+	i.URL = types.StringValue(cover.GetUrl())
+	i.RemoteURL = types.StringValue(cover.GetRemoteUrl())
 }
 
 func (s *Series) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.SeriesResource {
@@ -297,9 +1211,64 @@ func (s *Series) read(ctx context.Context, diags *diag.Diagnostics) *sonarr.Seri
 	series.SetMonitored(s.Monitored.ValueBool())
 	series.SetSeasonFolder(s.SeasonFolder.ValueBool())
 	series.SetPath(s.Path.ValueString())
-	series.SetRootFolderPath(s.Path.ValueString())
+	series.SetRootFolderPath(s.RootFolderPath.ValueString())
 	series.SetUseSceneNumbering(s.UseSceneNumbering.ValueBool())
+	series.SetSeriesType(sonarr.SeriesTypes(s.SeriesType.ValueString()))
+	series.SetMonitorNewItems(sonarr.NewItemMonitorTypes(s.MonitorNewItems.ValueString()))
 	diags.Append(s.Tags.ElementsAs(ctx, &series.Tags, true)...)
 
 	return series
 }
+
+// seriesFolderTokens are the series folder format tokens replaced by renderSeriesFolder.
+var seriesFolderTokens = map[string]func(*sonarr.SeriesResource) string{
+	"{Series Title}": func(s *sonarr.SeriesResource) string { return s.GetTitle() },
+	"{Series TitleYear}": func(s *sonarr.SeriesResource) string {
+		if s.GetYear() == 0 || strings.HasSuffix(s.GetTitle(), fmt.Sprintf("(%d)", s.GetYear())) {
+			return s.GetTitle()
+		}
+
+		return fmt.Sprintf("%s (%d)", s.GetTitle(), s.GetYear())
+	},
+	"{Series Year}": func(s *sonarr.SeriesResource) string { return strconv.Itoa(int(s.GetYear())) },
+	"{TvdbId}":      func(s *sonarr.SeriesResource) string { return strconv.Itoa(int(s.GetTvdbId())) },
+	"{TvMazeId}":    func(s *sonarr.SeriesResource) string { return strconv.Itoa(int(s.GetTvMazeId())) },
+	"{ImdbId}":      func(s *sonarr.SeriesResource) string { return s.GetImdbId() },
+}
+
+// renderSeriesFolder builds the series folder name from the naming format.
+// Only the most common tokens are supported and illegal characters are removed.
+func renderSeriesFolder(format string, series *sonarr.SeriesResource) string {
+	folder := format
+	for token, value := range seriesFolderTokens {
+		folder = strings.ReplaceAll(folder, token, value(series))
+	}
+
+	folder = strings.NewReplacer(": ", " - ", ":", "-").Replace(folder)
+	folder = strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`\/*?"<>|`, r) {
+			return -1
+		}
+
+		return r
+	}, folder)
+
+	return strings.TrimSpace(folder)
+}
+
+// joinSeriesPath joins the root folder and the series folder, using the root folder path separator.
+func joinSeriesPath(root, folder string) string {
+	separator := "/"
+	if strings.Contains(root, `\`) && !strings.Contains(root, "/") {
+		separator = `\`
+	}
+
+	return strings.TrimRight(root, `/\`) + separator + folder
+}
+
+// seriesFolderName returns the last element of the series path.
+func seriesFolderName(seriesPath string) string {
+	seriesPath = strings.TrimRight(seriesPath, `/\`)
+
+	return seriesPath[strings.LastIndexAny(seriesPath, `/\`)+1:]
+}
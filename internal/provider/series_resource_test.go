@@ -1,11 +1,26 @@
 package provider
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"net/http"
+	"os"
 	"regexp"
+	"slices"
+	"strconv"
 	"testing"
 
+	"github.com/devopsarr/sonarr-go/sonarr"
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	resourceschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/stretchr/testify/assert"
 )
 
 func TestAccSeriesResource(t *testing.T) {
@@ -46,6 +61,19 @@ func TestAccSeriesResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccSeriesResourceConfig(81189, "Breaking Bad", "breaking-bad", "true"),
+				Check:              testAccCheckResourceDisappears("sonarr_series.test", "series"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccSeriesResourceConfig(81189, "Breaking Bad", "breaking-bad", "true"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_series.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})
@@ -68,3 +96,748 @@ func testAccSeriesResourceConfig(id int, title, slug, monitored string) string {
 	}
 	`, title, slug, id, monitored, slug)
 }
+
+func TestAccSeriesResourceSeriesType(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid series type
+			{
+				Config:      testAccSeriesResourceSeriesTypeConfig("cartoon", "all"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Match"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceSeriesTypeConfig("daily", "none"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.type", "series_type", "daily"),
+					resource.TestCheckResourceAttr("sonarr_series.type", "monitor_new_items", "none"),
+					resource.TestCheckResourceAttr("sonarr_series.type", "network", "NBC"),
+					resource.TestCheckResourceAttr("sonarr_series.type", "year", "1994"),
+					resource.TestCheckTypeSetElemAttr("sonarr_series.type", "genres.*", "Comedy"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_series.type", "images.*", map[string]string{"cover_type": "poster"}),
+					resource.TestCheckResourceAttr("sonarr_series.type", "statistics.season_count", "10"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccSeriesResourceSeriesTypeConfig("anime", "all"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("sonarr_series.type", tfjsonpath.New("network"), knownvalue.StringExact("NBC")),
+						plancheck.ExpectKnownValue("sonarr_series.type", tfjsonpath.New("year"), knownvalue.Int64Exact(1994)),
+						plancheck.ExpectUnknownValue("sonarr_series.type", tfjsonpath.New("statistics")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.type", "series_type", "anime"),
+					resource.TestCheckResourceAttr("sonarr_series.type", "monitor_new_items", "all"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_series.type",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSeriesResourceSeriesTypeConfig(seriesType, monitorNewItems string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "type" {
+		title      = "Friends"
+		title_slug = "friends"
+		tvdb_id    = 79168
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/friends"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		series_type       = "%s"
+		monitor_new_items = "%s"
+	}
+	`, seriesType, monitorNewItems)
+}
+
+func TestAccSeriesResourceWaitForRefresh(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid timeout
+			{
+				Config:      testAccSeriesResourceWaitForRefreshConfig("5 minutes"),
+				ExpectError: regexp.MustCompile("Invalid Attribute Value Time Duration"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceWaitForRefreshConfig("5m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.refresh", "wait_for_refresh", "true"),
+					resource.TestCheckResourceAttr("sonarr_series.refresh", "timeouts.create", "5m"),
+					testAccCheckSeriesRefreshCommand("sonarr_series.refresh"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccSeriesResourceWaitForRefreshConfig("10m"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.refresh", "timeouts.create", "10m"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_series.refresh",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"wait_for_refresh", "timeouts"},
+			},
+		},
+	})
+}
+
+func testAccSeriesResourceWaitForRefreshConfig(timeout string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "refresh" {
+		tvdb_id            = 80379
+		root_folder_path   = "/config"
+		quality_profile_id = 1
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+
+		wait_for_refresh = true
+
+		timeouts {
+			create = "%s"
+			update = "%s"
+		}
+	}
+	`, timeout, timeout)
+}
+
+func TestAccSeriesResourceLookup(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Not found testing
+			{
+				Config:      testAccSeriesResourceLookupConfig(0, "/config"),
+				ExpectError: regexp.MustCompile("Unable to find series"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceLookupConfig(273181, "/config"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("sonarr_series.lookup", tfjsonpath.New("title"), knownvalue.StringExact("Better Call Saul")),
+						plancheck.ExpectKnownValue("sonarr_series.lookup", tfjsonpath.New("path"), knownvalue.StringExact("/config/Better Call Saul")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "title", "Better Call Saul"),
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "title_slug", "better-call-saul"),
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "path", "/config/Better Call Saul"),
+				),
+			},
+			// Update root folder testing
+			{
+				Config: testAccSeriesResourceLookupConfig(273181, "/config/tv"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectKnownValue("sonarr_series.lookup", tfjsonpath.New("path"), knownvalue.StringExact("/config/tv/Better Call Saul")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "path", "/config/tv/Better Call Saul"),
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "root_folder_path", "/config/tv"),
+				),
+			},
+			// Update root folder unknown at plan time testing
+			{
+				Config: testAccSeriesResourceLookupUnknownRootConfig("/config/shows"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectUnknownValue("sonarr_series.lookup", tfjsonpath.New("path")),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "path", "/config/shows/Better Call Saul"),
+					resource.TestCheckResourceAttr("sonarr_series.lookup", "root_folder_path", "/config/shows"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_series.lookup",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccSeriesResourceLookupConfig(tvdbID int, rootFolder string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "lookup" {
+		tvdb_id          = %d
+		root_folder_path = "%s"
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		quality_profile_id  = 1
+	}
+	`, tvdbID, rootFolder)
+}
+
+func testAccSeriesResourceLookupUnknownRootConfig(rootFolder string) string {
+	return fmt.Sprintf(`
+	resource "terraform_data" "root" {
+		input = "%s"
+	}
+
+	resource "sonarr_series" "lookup" {
+		tvdb_id          = 273181
+		root_folder_path = terraform_data.root.output
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		quality_profile_id  = 1
+	}
+	`, rootFolder)
+}
+
+func TestRenderSeriesFolder(t *testing.T) {
+	t.Parallel()
+
+	series := sonarr.NewSeriesResource()
+	series.SetTitle("Marvel's Agents of S.H.I.E.L.D.: Slingshot")
+	series.SetYear(2016)
+	series.SetTvdbId(311818)
+	series.SetImdbId("tt6426346")
+
+	tests := map[string]struct {
+		format   string
+		expected string
+	}{
+		"title":      {format: "{Series Title}", expected: "Marvel's Agents of S.H.I.E.L.D. - Slingshot"},
+		"title_year": {format: "{Series TitleYear}", expected: "Marvel's Agents of S.H.I.E.L.D. - Slingshot (2016)"},
+		"ids":        {format: "{Series Title} {TvdbId} [{ImdbId}]", expected: "Marvel's Agents of S.H.I.E.L.D. - Slingshot 311818 [tt6426346]"},
+		"unknown":    {format: "{Series CleanTitle}", expected: "{Series CleanTitle}"},
+	}
+	for name, test := range tests {
+		test := test
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+			assert.Equal(t, test.expected, renderSeriesFolder(test.format, series))
+		})
+	}
+}
+
+func TestJoinSeriesPath(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, "/tv/Series", joinSeriesPath("/tv/", "Series"))
+	assert.Equal(t, `C:\TV\Series`, joinSeriesPath(`C:\TV`, "Series"))
+	assert.Equal(t, "Series", seriesFolderName("/tv/Series/"))
+	assert.Equal(t, "Series", seriesFolderName(`C:\TV\Series`))
+}
+
+func TestSeriesResourceMetadataSchema(t *testing.T) {
+	t.Parallel()
+
+	var diags diag.Diagnostics
+
+	attributes := SeriesResource{}.getMetadataSchema(&diags).Attributes
+	assert.False(t, diags.HasError(), diags)
+	assertComputedAttributes(t, "", SeriesDataSource{}.getMetadataSchema().Attributes, attributes)
+
+	assert.Nil(t, SeriesResource{}.computedAttribute("ended", datasourceschema.BoolAttribute{Computed: true}, true, &diags))
+	assert.True(t, diags.HasError())
+}
+
+// assertComputedAttributes checks that every data source attribute is mapped to a computed resource one.
+func assertComputedAttributes(t *testing.T, prefix string, expected map[string]datasourceschema.Attribute, actual map[string]resourceschema.Attribute) {
+	t.Helper()
+
+	assert.Len(t, actual, len(expected), prefix)
+
+	for name, attribute := range expected {
+		output, ok := actual[name]
+		if !assert.True(t, ok, prefix+name) {
+			continue
+		}
+
+		assert.True(t, output.IsComputed(), prefix+name)
+		assert.Equal(t, attribute.GetMarkdownDescription(), output.GetMarkdownDescription(), prefix+name)
+
+		switch attribute := attribute.(type) {
+		case datasourceschema.SetNestedAttribute:
+			nested, ok := output.(resourceschema.SetNestedAttribute)
+			if assert.True(t, ok, prefix+name) {
+				assertComputedAttributes(t, prefix+name+".", attribute.NestedObject.Attributes, nested.NestedObject.Attributes)
+			}
+		case datasourceschema.SingleNestedAttribute:
+			nested, ok := output.(resourceschema.SingleNestedAttribute)
+			if assert.True(t, ok, prefix+name) {
+				assertComputedAttributes(t, prefix+name+".", attribute.Attributes, nested.Attributes)
+			}
+		}
+	}
+}
+
+func TestAccSeriesResourceAdoptExisting(t *testing.T) {
+	t.Parallel()
+
+	var existingID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Already added testing
+			{
+				PreConfig: func() {
+					series := sonarr.NewSeriesResource()
+					series.SetTitle("How I Met Your Mother")
+					series.SetTitleSlug("how-i-met-your-mother")
+					series.SetTvdbId(75760)
+					series.SetQualityProfileId(1)
+					series.SetPath("/config/how-i-met-your-mother")
+					series.SetRootFolderPath("/config")
+
+					response, _, err := testAccAPIClient().SeriesAPI.CreateSeries(context.Background()).SeriesResource(*series).Execute()
+					if err != nil {
+						t.Fatal(err)
+					}
+
+					existingID = strconv.Itoa(int(response.GetId()))
+				},
+				Config:      testAccSeriesResourceAdoptExistingConfig(false, "how-i-met-your-mother"),
+				ExpectError: regexp.MustCompile(`already\s+been\s+added`),
+			},
+			// Different path testing
+			{
+				Config:      testAccSeriesResourceAdoptExistingConfig(true, "himym"),
+				ExpectError: regexp.MustCompile(`already\s+in\s+Sonarr\s+at\s+'/config/how-i-met-your-mother'`),
+			},
+			// Adopt and Read testing
+			{
+				Config: testAccSeriesResourceAdoptExistingConfig(true, "how-i-met-your-mother"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrWith("sonarr_series.adopt", "id", func(value string) error {
+						if value != existingID {
+							return fmt.Errorf("expected adopted series %s, got: %s", existingID, value)
+						}
+
+						return nil
+					}),
+					resource.TestCheckResourceAttr("sonarr_series.adopt", "monitored", "true"),
+					resource.TestCheckResourceAttr("sonarr_series.adopt", "series_type", "daily"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_series.adopt",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"adopt_existing"},
+			},
+		},
+	})
+}
+
+func testAccSeriesResourceAdoptExistingConfig(adopt bool, folder string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "adopt" {
+		tvdb_id          = 75760
+		root_folder_path = "/config"
+		path             = "/config/%s"
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		quality_profile_id  = 1
+		series_type         = "daily"
+
+		adopt_existing = %t
+	}
+	`, folder, adopt)
+}
+
+func TestAccSeriesResourceAddOptions(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Invalid monitor
+			{
+				Config:      testAccSeriesResourceAddOptionsConfig("everything"),
+				ExpectError: regexp.MustCompile("Attribute add_options.monitor value must be one of"),
+			},
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceAddOptionsConfig("none"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.add_options", "add_options.monitor", "none"),
+					resource.TestCheckResourceAttr("sonarr_series.add_options", "add_options.search_for_missing_episodes", "false"),
+					resource.TestCheckResourceAttr("sonarr_series.add_options", "add_options.search_for_cutoff_unmet_episodes", "true"),
+					resource.TestCheckResourceAttr("sonarr_series.add_options", "add_options.ignore_episodes_with_files", "false"),
+					resource.TestCheckResourceAttrSet("sonarr_series.add_options", "id"),
+				),
+			},
+			// Update does not recreate
+			{
+				Config: testAccSeriesResourceAddOptionsConfig("future"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("sonarr_series.add_options", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.TestCheckResourceAttr("sonarr_series.add_options", "add_options.monitor", "future"),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_series.add_options",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"add_options"},
+			},
+		},
+	})
+}
+
+func testAccSeriesResourceAddOptionsConfig(monitor string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "add_options" {
+		title      = "The Office"
+		title_slug = "the-office"
+		tvdb_id    = 73244
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/the-office"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		add_options = {
+			monitor                     = "%s"
+			search_for_missing_episodes = false
+		}
+	}
+	`, monitor)
+}
+
+func TestAccSeriesResourceSeasons(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Unknown season
+			{
+				Config:      testAccSeriesResourceSeasonsConfig(`{ season_number = 99, monitored = true }`),
+				ExpectError: regexp.MustCompile("has no season 99"),
+			},
+			// Create and Read testing, seasons are applied after the refresh monitoring all seasons
+			{
+				Config: testAccSeriesResourceSeasonsConfig(`{ season_number = 1, monitored = false }, { season_number = 3, monitored = true }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.seasons", "seasons.#", "2"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_series.seasons", "seasons.*", map[string]string{"season_number": "1", "monitored": "false"}),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_series.seasons", "seasons.*", map[string]string{"season_number": "3", "monitored": "true"}),
+					testAccCheckSeriesSeasonMonitored("sonarr_series.seasons", 1, false),
+					testAccCheckSeriesSeasonMonitored("sonarr_series.seasons", 2, true),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: testAccSeriesResourceSeasonsConfig(`{ season_number = 3, monitored = false }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.seasons", "seasons.#", "1"),
+					resource.TestCheckTypeSetElemNestedAttrs("sonarr_series.seasons", "seasons.*", map[string]string{"season_number": "3", "monitored": "false"}),
+					testAccCheckSeriesSeasonMonitored("sonarr_series.seasons", 1, false),
+					testAccCheckSeriesSeasonMonitored("sonarr_series.seasons", 3, false),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_series.seasons",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"seasons", "add_options"},
+			},
+		},
+	})
+}
+
+func TestAccSeriesResourceDestroyOptions(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceDestroyOptionsConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.destroy", "delete_files_on_destroy", "true"),
+					resource.TestCheckResourceAttr("sonarr_series.destroy", "add_import_list_exclusion_on_destroy", "true"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "sonarr_series.destroy",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"delete_files_on_destroy", "add_import_list_exclusion_on_destroy"},
+			},
+			// Destroy the series
+			{
+				Config: testAccImportListExclusionsDataSourceConfig,
+			},
+			// Check the import list exclusion
+			{
+				Config: testAccImportListExclusionsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemNestedAttrs("data.sonarr_import_list_exclusions.test", "import_list_exclusions.*", map[string]string{"tvdb_id": "269613"}),
+				),
+			},
+		},
+	})
+}
+
+const testAccSeriesResourceDestroyOptionsConfig = `
+resource "sonarr_series" "destroy" {
+	title      = "Fargo"
+	title_slug = "fargo"
+	tvdb_id    = 269613
+
+	monitored           = false
+	season_folder       = true
+	use_scene_numbering = false
+	path                = "/config/fargo"
+	root_folder_path    = "/config"
+
+	quality_profile_id  = 1
+
+	delete_files_on_destroy              = true
+	add_import_list_exclusion_on_destroy = true
+}
+`
+
+func TestAccSeriesResourceMoveFiles(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccSeriesResourceMoveFilesConfig("/config/stranger-things", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.move", "move_files_on_path_change", "true"),
+				),
+			},
+			// Update with move testing
+			{
+				Config: testAccSeriesResourceMoveFilesConfig("/config/stranger_things", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.move", "path", "/config/stranger_things"),
+					testAccCheckSeriesMoveCommand("/config/stranger_things", true),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "sonarr_series.move",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update without move testing
+			{
+				Config: testAccSeriesResourceMoveFilesConfig("/config/stranger-things-tv", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("sonarr_series.move", "path", "/config/stranger-things-tv"),
+					testAccCheckSeriesMoveCommand("/config/stranger-things-tv", false),
+				),
+			},
+		},
+	})
+}
+
+func testAccSeriesResourceMoveFilesConfig(path string, move bool) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "move" {
+		title      = "Stranger Things"
+		title_slug = "stranger-things"
+		tvdb_id    = 305288
+
+		monitored           = false
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "%s"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		move_files_on_path_change = %t
+	}
+	`, path, move)
+}
+
+// testAccCheckSeriesMoveCommand checks if Sonarr completed a move of series files to the destination.
+func testAccCheckSeriesMoveCommand(destination string, moved bool) resource.TestCheckFunc {
+	return func(_ *terraform.State) error {
+		commands, err := testAccListRawCommands()
+		if err != nil {
+			return err
+		}
+
+		found := false
+
+		for _, command := range commands {
+			if command.Name == moveSeriesCommand && command.Status == "completed" && command.Body.DestinationPath == destination {
+				found = true
+			}
+		}
+
+		if found != moved {
+			return fmt.Errorf("expected completed move to %s: %t, got: %t", destination, moved, found)
+		}
+
+		return nil
+	}
+}
+
+// testAccCheckSeriesRefreshCommand checks if Sonarr completed the refresh of the series.
+func testAccCheckSeriesRefreshCommand(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", name)
+		}
+
+		id, _ := strconv.Atoi(rs.Primary.ID)
+
+		commands, err := testAccListRawCommands()
+		if err != nil {
+			return err
+		}
+
+		for _, command := range commands {
+			if command.Name == refreshSeriesCommand && command.Status == "completed" && slices.Contains(command.Body.SeriesIDs, id) {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("expected completed refresh of series %d", id)
+	}
+}
+
+// testAccRawCommand is a command as returned by Sonarr, including the body fields the client does not decode.
+type testAccRawCommand struct {
+	Body struct {
+		DestinationPath string `json:"destinationPath"`
+		SeriesIDs       []int  `json:"seriesIds"`
+	} `json:"body"`
+	Name   string `json:"name"`
+	Status string `json:"status"`
+}
+
+// testAccListRawCommands reads the commands as raw JSON.
+func testAccListRawCommands() ([]testAccRawCommand, error) {
+	request, err := http.NewRequest(http.MethodGet, os.Getenv("SONARR_URL")+"/api/v3/command", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	request.Header.Set("X-Api-Key", os.Getenv("SONARR_API_KEY"))
+
+	response, err := http.DefaultClient.Do(request)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+
+	var commands []testAccRawCommand
+
+	err = json.NewDecoder(response.Body).Decode(&commands)
+
+	return commands, err
+}
+
+func testAccSeriesResourceSeasonsConfig(seasons string) string {
+	return fmt.Sprintf(`
+	resource "sonarr_series" "seasons" {
+		title      = "Game of Thrones"
+		title_slug = "game-of-thrones"
+		tvdb_id    = 121361
+
+		monitored           = true
+		season_folder       = true
+		use_scene_numbering = false
+		path                = "/config/game-of-thrones"
+		root_folder_path    = "/config"
+
+		quality_profile_id  = 1
+
+		add_options = {
+			monitor = "all"
+		}
+
+		seasons = [%s]
+	}
+	`, seasons)
+}
+
+// testAccCheckSeriesSeasonMonitored checks the season monitoring directly on Sonarr.
+func testAccCheckSeriesSeasonMonitored(name string, season int32, monitored bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource not found in state: %s", name)
+		}
+
+		id, err := strconv.Atoi(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		series, _, err := testAccAPIClient().SeriesAPI.GetSeriesById(context.Background(), int32(id)).Execute()
+		if err != nil {
+			return err
+		}
+
+		for _, s := range series.GetSeasons() {
+			if s.GetSeasonNumber() == season && s.GetMonitored() == monitored {
+				return nil
+			}
+		}
+
+		return fmt.Errorf("season %d of %s is not monitored=%t", season, name, monitored)
+	}
+}
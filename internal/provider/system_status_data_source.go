@@ -173,7 +173,7 @@ func (d *SystemStatusDataSource) Configure(ctx context.Context, req datasource.C
 
 func (d *SystemStatusDataSource) Read(ctx context.Context, _ datasource.ReadRequest, resp *datasource.ReadResponse) {
 	// Get system status current value
-	response, _, err := d.client.SystemAPI.GetSystemStatus(d.auth).Execute()
+	response, _, err := d.client.SystemAPI.GetSystemStatus(helpers.WithAuth(ctx, d.auth)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, systemStatusDataSourceName, err))
 
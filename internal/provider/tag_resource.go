@@ -102,9 +102,9 @@ func (r *TagResource) Create(ctx context.Context, req resource.CreateRequest, re
 	request := *sonarr.NewTagResource()
 	request.SetLabel(tag.Label.ValueString())
 
-	response, _, err := r.client.TagAPI.CreateTag(r.auth).TagResource(request).Execute()
+	response, _, err := r.client.TagAPI.CreateTag(helpers.WithAuth(ctx, r.auth)).TagResource(request).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Create, tagResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Create, tagResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -126,9 +126,9 @@ func (r *TagResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	}
 
 	// Get tag current value
-	response, _, err := r.client.TagAPI.GetTagById(r.auth, int32(tag.ID.ValueInt64())).Execute()
+	response, httpResp, err := r.client.TagAPI.GetTagById(helpers.WithAuth(ctx, r.auth), int32(tag.ID.ValueInt64())).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Read, tagResourceName, err))
+		helpers.HandleReadError(ctx, tagResourceName, httpResp, err, resp)
 
 		return
 	}
@@ -154,9 +154,9 @@ func (r *TagResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	tagResource.SetLabel(tag.Label.ValueString())
 	tagResource.SetId(int32(tag.ID.ValueInt64()))
 
-	response, _, err := r.client.TagAPI.UpdateTag(r.auth, fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
+	response, _, err := r.client.TagAPI.UpdateTag(helpers.WithAuth(ctx, r.auth), fmt.Sprint(tagResource.GetId())).TagResource(tagResource).Execute()
 	if err != nil {
-		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Update, tagResourceName, err))
+		helpers.HandleWriteError(ctx, helpers.Update, tagResourceName, err, helpers.Fields{}, req.Plan, &resp.Diagnostics)
 
 		return
 	}
@@ -177,7 +177,7 @@ func (r *TagResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 	}
 
 	// Delete tag current value
-	_, err := r.client.TagAPI.DeleteTag(r.auth, int32(ID)).Execute()
+	_, err := r.client.TagAPI.DeleteTag(helpers.WithAuth(ctx, r.auth), int32(ID)).Execute()
 	if err != nil {
 		resp.Diagnostics.AddError(helpers.ClientError, helpers.ParseClientError(helpers.Delete, tagResourceName, err))
 
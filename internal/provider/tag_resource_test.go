@@ -47,6 +47,19 @@ func TestAccTagResource(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Remove outside of terraform testing
+			{
+				Config:             testAccTagResourceConfig("test", "1080p"),
+				Check:              testAccCheckResourceDisappears("sonarr_tag.test", "tag"),
+				ExpectNonEmptyPlan: true,
+			},
+			// Recreate after removal testing
+			{
+				Config: testAccTagResourceConfig("test", "1080p"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("sonarr_tag.test", "id"),
+				),
+			},
 			// Delete testing automatically occurs in TestCase
 		},
 	})